@@ -0,0 +1,95 @@
+package rowbinary
+
+// AppendType is implemented by types which can append RowBinary encoding of a value to byte slice
+// without Writer. All built-in types implement it, including composite ones.
+//
+// Types created with MakeType and MakeTypeWrapAny delegate to the wrapped implementation
+// or fall back to Write into the slice.
+type AppendType[T any] interface {
+	// Append appends RowBinary encoding of v to dst
+	Append(dst []byte, v T) ([]byte, error)
+}
+
+// appendAnyType is implemented by typeWrapper for encoding of nested Any types
+type appendAnyType interface {
+	appendAny(dst []byte, v any) ([]byte, error)
+}
+
+var _ AppendType[uint8] = &typeWrapper[uint8]{}
+
+// Append appends RowBinary encoding of v to dst, e.g. to build rows in reusable buffer.
+func Append[T any](dst []byte, tp Type[T], v T) ([]byte, error) {
+	if at, ok := tp.(AppendType[T]); ok {
+		return at.Append(dst, v)
+	}
+	w := appendWriter{dst: dst}
+	err := tp.Write(&w, v)
+	return w.dst, err
+}
+
+// AppendAny is like Append for types known at runtime only.
+func AppendAny(dst []byte, tp Any, v any) ([]byte, error) {
+	return appendAny(dst, tp, v)
+}
+
+func appendAny(dst []byte, tp Any, v any) ([]byte, error) {
+	if at, ok := tp.(appendAnyType); ok {
+		return at.appendAny(dst, v)
+	}
+	w := appendWriter{dst: dst}
+	err := tp.WriteAny(&w, v)
+	return w.dst, err
+}
+
+func (t *typeWrapper[T]) Append(dst []byte, v T) ([]byte, error) {
+	if at, ok := t.PreType.(AppendType[T]); ok {
+		return at.Append(dst, v)
+	}
+	w := appendWriter{dst: dst}
+	err := t.PreType.Write(&w, v)
+	return w.dst, err
+}
+
+func (t *typeWrapper[T]) appendAny(dst []byte, v any) ([]byte, error) {
+	value, ok := v.(T)
+	if !ok {
+		// WriteAny of PreType may accept other types
+		w := appendWriter{dst: dst}
+		err := t.PreType.WriteAny(&w, v)
+		return w.dst, err
+	}
+	return t.Append(dst, value)
+}
+
+func (t typeWrapperAny[T]) Append(dst []byte, v T) ([]byte, error) {
+	if at, ok := t.BaseType.(AppendType[T]); ok {
+		return at.Append(dst, v)
+	}
+	w := appendWriter{dst: dst}
+	err := t.BaseType.Write(&w, v)
+	return w.dst, err
+}
+
+func (t *customType[T]) Append(dst []byte, v T) ([]byte, error) {
+	return Append(dst, t.Type, v)
+}
+
+// appendWriter is Writer appending to byte slice, used for types without Append
+type appendWriter struct {
+	dst []byte
+	buf [16]byte
+}
+
+func (w *appendWriter) Write(p []byte) (int, error) {
+	w.dst = append(w.dst, p...)
+	return len(p), nil
+}
+
+func (w *appendWriter) WriteByte(b byte) error {
+	w.dst = append(w.dst, b)
+	return nil
+}
+
+func (w *appendWriter) Buffer() []byte {
+	return w.buf[:]
+}
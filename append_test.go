@@ -0,0 +1,34 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend(t *testing.T) {
+	for _, v := range testEncodeValues {
+		t.Run(v.Type.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			var buf bytes.Buffer
+			assert.NoError(v.Type.WriteAny(NewWriter(&buf), v.Value))
+
+			dst := []byte{42}
+			dst, err := AppendAny(dst, v.Type, v.Value)
+			assert.NoError(err)
+			assert.Equal(append([]byte{42}, buf.Bytes()...), dst)
+		})
+	}
+
+	b, err := Append(nil, Array(Nullable(UInt16)), []*uint16{pointer(uint16(1)), nil})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2, 0, 1, 0, 1}, b)
+
+	_, err = Append(nil, Enum8(map[string]int8{"a": 1}), "b")
+	assert.ErrorContains(t, err, "invalid enum value")
+
+	_, err = AppendAny(nil, UInt8, "a")
+	assert.Error(t, err)
+}
@@ -13,10 +13,13 @@ type typeArray[V any] struct {
 	valueType Type[V]
 	tbin      []byte
 	tstr      string
+	fixed     *arrayFixed[V]
 }
 
+// Array creates Array type of valueType. Arrays of fixed-width numbers, Date, DateTime and IPv4
+// are encoded and decoded as a whole block instead of element by element.
 func Array[V any](valueType Type[V]) Type[[]V] {
-	return MakeTypeWrapAny(typeArray[V]{valueType: valueType})
+	return MakeTypeWrapAny(typeArray[V]{valueType: valueType, fixed: newArrayFixed(valueType)})
 }
 
 func (t typeArray[V]) String() string {
@@ -32,6 +35,9 @@ func (t typeArray[V]) Write(w Writer, value []V) error {
 	if err != nil {
 		return err
 	}
+	if t.fixed != nil {
+		return t.fixed.write(w, value)
+	}
 	for i := 0; i < len(value); i++ {
 		err = t.valueType.Write(w, value[i])
 		if err != nil {
@@ -41,24 +47,118 @@ func (t typeArray[V]) Write(w Writer, value []V) error {
 	return err
 }
 
+func (t typeArray[V]) Append(dst []byte, v []V) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+	if t.fixed != nil {
+		return t.fixed.appendValues(dst, v)
+	}
+	var err error
+	for i := range v {
+		if dst, err = Append(dst, t.valueType, v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
 func (t typeArray[V]) Scan(r Reader, v *[]V) error {
-	n, err := binary.ReadUvarint(r)
+	n, err := readCollectionLen(r)
 	if err != nil {
 		return err
 	}
-	if *v == nil {
-		*v = make([]V, int(n))
-	} else if len(*v) >= int(n) {
-		*v = (*v)[:n]
-	} else {
-		*v = append(*v, make([]V, int(n)-len(*v))...)
+	s := (*v)[:0]
+	if s == nil {
+		s = make([]V, 0, preallocLen(n))
 	}
-
-	for i := 0; i < int(n); i++ {
-		if err := t.valueType.Scan(r, &(*v)[i]); err != nil {
+	// grow by chunks as elements are decoded, elements of previous value are reused
+	for len(s) < n {
+		from, to := len(s), growLen(len(s), n)
+		s = slices.Grow(s, to-from)[:to]
+		*v = s
+		if t.fixed != nil {
+			err = t.fixed.scan(r, s[from:to])
+		} else {
+			for i := from; i < to && err == nil; i++ {
+				err = t.valueType.Scan(r, &s[i])
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
+	*v = s
 
 	return nil
 }
+
+func (t typeArray[V]) Skip(r Reader) error {
+	n, err := readCollectionLen(r)
+	if err != nil {
+		return err
+	}
+	return skipN(r, t.valueType, n)
+}
+
+func (t typeArray[V]) coerce(v any) ([]V, error) {
+	if value, ok := v.([]V); ok {
+		return value, nil
+	}
+	elems, ok := coerceElems(v)
+	if !ok {
+		return nil, newTypeMismatchError[[]V](v)
+	}
+	ret := make([]V, len(elems))
+	for i, elem := range elems {
+		var err error
+		if ret[i], err = Coerce(t.valueType, elem); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (t typeArray[V]) AppendLiteral(dst []byte, v []V) ([]byte, error) {
+	dst = append(dst, '[')
+	for i := range v {
+		if i > 0 {
+			dst = append(dst, ", "...)
+		}
+		var err error
+		if dst, err = AppendLiteral(dst, t.valueType, v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
+
+func (t typeArray[V]) ScanLiteral(s *LiteralScanner, v *[]V) error {
+	if err := s.Expect('['); err != nil {
+		return err
+	}
+	if *v == nil {
+		*v = make([]V, 0)
+	}
+	*v = (*v)[:0]
+	return s.scanList(']', func() error {
+		var x V
+		if err := scanLiteral(s, t.valueType, &x); err != nil {
+			return err
+		}
+		*v = append(*v, x)
+		return nil
+	})
+}
+
+func (t typeArray[V]) AppendJSON(dst []byte, v []V, opts *JSONOptions) ([]byte, error) {
+	dst = append(dst, '[')
+	for i := range v {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		var err error
+		if dst, err = AppendJSON(dst, t.valueType, v[i], opts); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
@@ -38,30 +38,103 @@ func (t typeArrayAny) Write(w Writer, value []any) error {
 	return err
 }
 
+func (t typeArrayAny) Append(dst []byte, v []any) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+	var err error
+	for i := range v {
+		if dst, err = appendAny(dst, t.valueType, v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
 func (t typeArrayAny) Scan(r Reader, v *[]any) error {
-	n, err := binary.ReadUvarint(r)
+	n, err := readCollectionLen(r)
 	if err != nil {
 		return err
 	}
 	if *v == nil {
-		*v = make([]any, int(n))
-	} else if len(*v) >= int(n) {
-		*v = (*v)[:n]
-	} else {
-		*v = append(*v, make([]any, int(n)-len(*v))...)
+		*v = make([]any, 0, preallocLen(n))
 	}
+	*v = (*v)[:0]
 
-	for i := uint64(0); i < n; i++ {
+	for i := 0; i < n; i++ {
 		var value any
 		err := t.valueType.ScanAny(r, &value)
 		if err != nil {
 			return err
 		}
-		(*v)[i] = value
-		// if err := t.valueType.ScanAny(r, &(*v)[i]); err != nil {
-		// 	return err
-		// }
+		*v = append(*v, value)
 	}
 
 	return nil
 }
+
+func (t typeArrayAny) Skip(r Reader) error {
+	n, err := readCollectionLen(r)
+	if err != nil {
+		return err
+	}
+	return skipN(r, t.valueType, n)
+}
+
+func (t typeArrayAny) coerce(v any) ([]any, error) {
+	elems, ok := coerceElems(v)
+	if !ok {
+		return nil, newTypeMismatchError[[]any](v)
+	}
+	for i, elem := range elems {
+		var err error
+		if elems[i], err = coerceAny(t.valueType, elem); err != nil {
+			return nil, err
+		}
+	}
+	return elems, nil
+}
+
+func (t typeArrayAny) AppendLiteral(dst []byte, v []any) ([]byte, error) {
+	dst = append(dst, '[')
+	for i := range v {
+		if i > 0 {
+			dst = append(dst, ", "...)
+		}
+		var err error
+		if dst, err = appendLiteralAny(dst, t.valueType, v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
+
+func (t typeArrayAny) ScanLiteral(s *LiteralScanner, v *[]any) error {
+	if err := s.Expect('['); err != nil {
+		return err
+	}
+	if *v == nil {
+		*v = make([]any, 0)
+	}
+	*v = (*v)[:0]
+	return s.scanList(']', func() error {
+		x, err := scanLiteralAny(s, t.valueType)
+		if err != nil {
+			return err
+		}
+		*v = append(*v, x)
+		return nil
+	})
+}
+
+func (t typeArrayAny) AppendJSON(dst []byte, v []any, opts *JSONOptions) ([]byte, error) {
+	dst = append(dst, '[')
+	for i := range v {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		var err error
+		if dst, err = appendJSONAny(dst, t.valueType, v[i], opts); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
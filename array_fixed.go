@@ -0,0 +1,153 @@
+package rowbinary
+
+import (
+	"bufio"
+	"encoding/binary"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// nativeLittleEndian is true if in-memory representation of numbers matches RowBinary encoding
+var nativeLittleEndian = binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+
+var arrayBufPool = sync.Pool{
+	New: func() any {
+		return new([]byte)
+	},
+}
+
+// arrayFixed encodes and decodes arrays of fixed-width elements as a whole block
+// instead of calling Scan and Write of element type for every element
+type arrayFixed[V any] struct {
+	width int
+	// raw is set if memory of []V can be copied as is, decode is used otherwise
+	raw    bool
+	decode func(b []byte) V
+	append AppendType[V]
+}
+
+// newArrayFixed returns bulk codec for arrays of valueType or nil if elements are not fixed-width
+func newArrayFixed[V any](valueType Type[V]) *arrayFixed[V] {
+	app, ok := valueType.(AppendType[V])
+	if !ok {
+		return nil
+	}
+
+	decode, _, width := batchFixedCodec(valueType)
+	if decode == nil {
+		decode, width = arrayFixedDecoder(valueType)
+	} else if nativeLittleEndian {
+		var zero V
+		if int(unsafe.Sizeof(zero)) == width {
+			return &arrayFixed[V]{width: width, raw: true, append: app}
+		}
+	}
+	if decode == nil {
+		return nil
+	}
+	return &arrayFixed[V]{width: width, decode: decode, append: app}
+}
+
+// arrayFixedDecoder returns decoder of fixed-width types whose Go representation differs from encoding
+func arrayFixedDecoder[V any](tp Type[V]) (func(b []byte) V, int) {
+	bin := tp.Binary()
+	if len(bin) != 1 {
+		return nil, 0
+	}
+
+	var dec any
+	var width int
+	switch [1]byte{bin[0]} {
+	case BinaryTypeDate:
+		dec = func(b []byte) ValueDate {
+			tm := time.Unix(int64(binary.LittleEndian.Uint16(b))*secInDay, 0).UTC()
+			return ValueDate{Year: uint16(tm.Year()), Month: uint8(tm.Month()), Day: uint8(tm.Day())}
+		}
+		width = 2
+	case BinaryTypeDateTime:
+		dec = func(b []byte) time.Time {
+			return time.Unix(int64(binary.LittleEndian.Uint32(b)), 0).UTC()
+		}
+		width = 4
+	case BinaryTypeIPv4:
+		dec = func(b []byte) [4]byte {
+			return [4]byte{b[3], b[2], b[1], b[0]}
+		}
+		width = 4
+	}
+
+	decode, ok := dec.(func(b []byte) V)
+	if !ok {
+		return nil, 0
+	}
+	return decode, width
+}
+
+// rawBytes returns memory of v as byte slice
+func rawBytes[V any](v []V) []byte {
+	if len(v) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(v))), len(v)*int(unsafe.Sizeof(v[0])))
+}
+
+func (c *arrayFixed[V]) appendValues(dst []byte, v []V) ([]byte, error) {
+	if c.raw {
+		return append(dst, rawBytes(v)...), nil
+	}
+	var err error
+	for i := range v {
+		if dst, err = c.append.Append(dst, v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+func (c *arrayFixed[V]) write(w Writer, v []V) error {
+	if c.raw {
+		_, err := w.Write(rawBytes(v))
+		return err
+	}
+
+	p := arrayBufPool.Get().(*[]byte)
+	buf, err := c.appendValues((*p)[:0], v)
+	if err == nil {
+		_, err = w.Write(buf)
+	}
+	if cap(buf) <= appendBufMax {
+		*p = buf
+		arrayBufPool.Put(p)
+	}
+	return err
+}
+
+func (c *arrayFixed[V]) scan(r Reader, v []V) error {
+	for len(v) > 0 {
+		k := min(len(v), max(1, readChunkSize/c.width))
+		b, err := r.Peek(k * c.width)
+		if err == bufio.ErrBufferFull && len(b) >= c.width {
+			// chunk is larger than read buffer
+			k = len(b) / c.width
+			b, err = b[:k*c.width], nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if c.raw {
+			copy(rawBytes(v[:k]), b)
+		} else {
+			for i := range k {
+				v[i] = c.decode(b[i*c.width:])
+			}
+		}
+
+		if _, err := r.Discard(len(b)); err != nil {
+			return err
+		}
+		v = v[k:]
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package rowbinary
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testArrayFixed[V any](t *testing.T, tp Type[V], values []V) {
+	t.Run(tp.String(), func(t *testing.T) {
+		assert := assert.New(t)
+
+		fixed := newArrayFixed(tp)
+		if !assert.NotNil(fixed) {
+			return
+		}
+
+		// expected encoding is built element by element
+		expected := binary.AppendUvarint(nil, uint64(len(values)))
+		for _, v := range values {
+			var err error
+			expected, err = Append(expected, tp, v)
+			assert.NoError(err)
+		}
+
+		portable := *fixed
+		if portable.raw {
+			portable.raw = false
+			portable.decode, _, _ = batchFixedCodec(tp)
+		}
+
+		for _, arr := range []Type[[]V]{
+			Array(tp),
+			MakeTypeWrapAny(typeArray[V]{valueType: tp, fixed: &portable}),
+		} {
+			var buf bytes.Buffer
+			assert.NoError(arr.Write(NewWriter(&buf), values))
+			assert.Equal(expected, buf.Bytes())
+
+			b, err := arr.(AppendType[[]V]).Append(nil, values)
+			assert.NoError(err)
+			assert.Equal(expected, b)
+
+			// read buffer is smaller than array
+			var v []V
+			assert.NoError(arr.Scan(bufio.NewReaderSize(bytes.NewReader(expected), 16), &v))
+			assert.Equal(values, v)
+
+			_, _, err = Decode(arr, expected[:len(expected)-1])
+			assert.Error(err)
+		}
+	})
+}
+
+func TestArrayFixed(t *testing.T) {
+	seq := func(n int) []uint64 {
+		ret := make([]uint64, n)
+		for i := range ret {
+			ret[i] = uint64(i) * 0x0102030405
+		}
+		return ret
+	}
+
+	u32 := make([]uint32, 1000)
+	i16 := make([]int16, 1000)
+	f64 := make([]float64, 1000)
+	for i, v := range seq(1000) {
+		u32[i] = uint32(v)
+		i16[i] = -int16(v)
+		f64[i] = float64(i) / 3
+	}
+	f64[1] = math.Inf(-1)
+
+	testArrayFixed(t, UInt8, []uint8{1, 2, 255})
+	testArrayFixed(t, Int16, i16)
+	testArrayFixed(t, UInt32, u32)
+	testArrayFixed(t, UInt64, seq(20000))
+	testArrayFixed(t, Float32, []float32{1.5, -2, math.MaxFloat32})
+	testArrayFixed(t, Float64, f64)
+	testArrayFixed(t, Date, []ValueDate{{Year: 2024, Month: 2, Day: 29}, {Year: 1970, Month: 1, Day: 1}})
+	testArrayFixed(t, DateTime, []time.Time{time.Unix(1700000000, 0).UTC(), time.Unix(0, 0).UTC()})
+	testArrayFixed(t, IPv4, [][4]byte{{127, 0, 0, 1}, {10, 1, 2, 3}})
+
+	assert.Nil(t, newArrayFixed(String))
+	assert.Nil(t, newArrayFixed(Nullable(UInt32)))
+	assert.Nil(t, newArrayFixed(Bool))
+}
+
+func BenchmarkArrayUInt32_Write(b *testing.B) {
+	tp := Array(UInt32)
+	data := make([]uint32, 1000)
+	w := NewWriter(io.Discard)
+	for b.Loop() {
+		if err := tp.Write(w, data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkArrayUInt32_Scan(b *testing.B) {
+	tp := Array(UInt32)
+	data, err := Append(nil, tp, make([]uint32, 1000))
+	if err != nil {
+		b.Fatal(err)
+	}
+	var v []uint32
+	br := bytes.NewReader(data)
+	r := bufio.NewReader(br)
+	for b.Loop() {
+		br.Reset(data)
+		r.Reset(br)
+		if err := tp.Scan(r, &v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package rowbinary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// batchChunkSize is the size of data decoded at once by the fixed-width path of ReadBatch
+const batchChunkSize = 64 * 1024
+
+// BatchColumn is a typed column buffer for FormatReader.ReadBatch and FormatWriter.WriteColumns.
+// Use NewColumnBuffer or ColumnValues to create one.
+type BatchColumn interface {
+	batchType() Any
+	batchLen() int
+	batchWrite(w Writer, i int) error
+	// batchValue returns i-th value, it is used to check nil values for WithNilAsDefault
+	batchValue(i int) any
+	// batchPutFixed encodes n values starting from i at offset of rows with rowWidth bytes each
+	batchPutFixed(b []byte, i int, n int, rowWidth int, offset int)
+	batchReset()
+	batchScan(r Reader) error
+	// batchFixedWidth returns width of fixed-width numeric value or 0
+	batchFixedWidth() int
+	// batchAppendFixed decodes n values placed at offset of rows with rowWidth bytes each
+	batchAppendFixed(b []byte, n int, rowWidth int, offset int)
+}
+
+var _ BatchColumn = NewColumnBuffer(UInt64, 0)
+
+// ColumnBuffer holds values of a single column read by FormatReader.ReadBatch
+// or written by FormatWriter.WriteColumns. ReadBatch truncates values before every batch
+// and reuses memory of the previous one, so values must be copied if they are needed after the next batch.
+type ColumnBuffer[T any] struct {
+	Values []T
+	tp     Type[T]
+	decode func(b []byte) T
+	encode func(b []byte, v T)
+	width  int
+}
+
+// NewColumnBuffer creates column buffer for values of type tp with preallocated capacity.
+func NewColumnBuffer[T any](tp Type[T], capacity int) *ColumnBuffer[T] {
+	return ColumnValues(tp, make([]T, 0, capacity))
+}
+
+// ColumnValues creates column buffer holding values, e.g. for FormatWriter.WriteColumns.
+func ColumnValues[T any](tp Type[T], values []T) *ColumnBuffer[T] {
+	decode, encode, width := batchFixedCodec(tp)
+	return &ColumnBuffer[T]{
+		Values: values,
+		tp:     tp,
+		decode: decode,
+		encode: encode,
+		width:  width,
+	}
+}
+
+func (c *ColumnBuffer[T]) batchType() Any {
+	return c.tp
+}
+
+func (c *ColumnBuffer[T]) batchLen() int {
+	return len(c.Values)
+}
+
+func (c *ColumnBuffer[T]) batchWrite(w Writer, i int) error {
+	return c.tp.Write(w, c.Values[i])
+}
+
+func (c *ColumnBuffer[T]) batchValue(i int) any {
+	return c.Values[i]
+}
+
+func (c *ColumnBuffer[T]) batchPutFixed(b []byte, i int, n int, rowWidth int, offset int) {
+	for j, v := range c.Values[i : i+n] {
+		c.encode(b[j*rowWidth+offset:], v)
+	}
+}
+
+func (c *ColumnBuffer[T]) batchReset() {
+	c.Values = c.Values[:0]
+}
+
+func (c *ColumnBuffer[T]) batchScan(r Reader) error {
+	var zero T
+	c.Values = append(c.Values, zero)
+	return c.tp.Scan(r, &c.Values[len(c.Values)-1])
+}
+
+func (c *ColumnBuffer[T]) batchFixedWidth() int {
+	return c.width
+}
+
+func (c *ColumnBuffer[T]) batchAppendFixed(b []byte, n int, rowWidth int, offset int) {
+	c.Values = append(c.Values, make([]T, n)...)
+	values := c.Values[len(c.Values)-n:]
+	for i := range values {
+		values[i] = c.decode(b[i*rowWidth+offset:])
+	}
+}
+
+// batchFixedCodec returns decoder and encoder of fixed-width numeric type and its width
+func batchFixedCodec[T any](tp Type[T]) (func(b []byte) T, func(b []byte, v T), int) {
+	bin := tp.Binary()
+	if len(bin) != 1 {
+		return nil, nil, 0
+	}
+
+	var dec, enc any
+	var width int
+	switch [1]byte{bin[0]} {
+	case BinaryTypeUInt8:
+		dec = func(b []byte) uint8 { return b[0] }
+		enc = func(b []byte, v uint8) { b[0] = v }
+		width = 1
+	case BinaryTypeInt8:
+		dec = func(b []byte) int8 { return int8(b[0]) }
+		enc = func(b []byte, v int8) { b[0] = byte(v) }
+		width = 1
+	case BinaryTypeUInt16:
+		dec = binary.LittleEndian.Uint16
+		enc = binary.LittleEndian.PutUint16
+		width = 2
+	case BinaryTypeInt16:
+		dec = func(b []byte) int16 { return int16(binary.LittleEndian.Uint16(b)) }
+		enc = func(b []byte, v int16) { binary.LittleEndian.PutUint16(b, uint16(v)) }
+		width = 2
+	case BinaryTypeUInt32:
+		dec = binary.LittleEndian.Uint32
+		enc = binary.LittleEndian.PutUint32
+		width = 4
+	case BinaryTypeInt32:
+		dec = func(b []byte) int32 { return int32(binary.LittleEndian.Uint32(b)) }
+		enc = func(b []byte, v int32) { binary.LittleEndian.PutUint32(b, uint32(v)) }
+		width = 4
+	case BinaryTypeUInt64:
+		dec = binary.LittleEndian.Uint64
+		enc = binary.LittleEndian.PutUint64
+		width = 8
+	case BinaryTypeInt64:
+		dec = func(b []byte) int64 { return int64(binary.LittleEndian.Uint64(b)) }
+		enc = func(b []byte, v int64) { binary.LittleEndian.PutUint64(b, uint64(v)) }
+		width = 8
+	case BinaryTypeFloat32:
+		dec = func(b []byte) float32 { return math.Float32frombits(binary.LittleEndian.Uint32(b)) }
+		enc = func(b []byte, v float32) { binary.LittleEndian.PutUint32(b, math.Float32bits(v)) }
+		width = 4
+	case BinaryTypeFloat64:
+		dec = func(b []byte) float64 { return math.Float64frombits(binary.LittleEndian.Uint64(b)) }
+		enc = func(b []byte, v float64) { binary.LittleEndian.PutUint64(b, math.Float64bits(v)) }
+		width = 8
+	}
+
+	// type with the same encoding but different Go type
+	decode, ok := dec.(func(b []byte) T)
+	if !ok {
+		return nil, nil, 0
+	}
+	return decode, enc.(func(b []byte, v T)), width
+}
+
+// ReadBatch reads up to n rows into column buffers, one buffer for every result column in order
+// (for every projected column if WithProjection is set).
+// Buffers are truncated before reading. Returns number of rows read, 0 means end of data.
+//
+// If all columns are fixed-width numbers (integers and floats), values are decoded
+// directly from the read buffer without per-value Scan calls.
+func (r *FormatReader) ReadBatch(n int, cols ...BatchColumn) (int, error) {
+	if err := r.check(); err != nil {
+		return 0, err
+	}
+	columns := r.decoded
+	if len(cols) != len(columns) {
+		return 0, r.setErr(fmt.Errorf("got %d batch columns, expected %d", len(cols), len(columns)))
+	}
+	if r.index != 0 {
+		return 0, r.setErr(errors.New("batch must start at the beginning of row"))
+	}
+
+	rowWidth := 0
+	if r.skipped != nil {
+		rowWidth = -1
+	}
+	for i, col := range cols {
+		col.batchReset()
+		if tp := col.batchType(); tp.ID() != columns[i].tp.ID() {
+			return 0, r.setErr(fmt.Errorf("type mismatch for column %s. expected %s, got %s", columns[i].name, columns[i].tp.String(), tp.String()))
+		}
+		if w := col.batchFixedWidth(); w > 0 && rowWidth >= 0 {
+			rowWidth += w
+		} else {
+			rowWidth = -1
+		}
+	}
+
+	rows := 0
+	for rows < n && r.Next() {
+		if rowWidth > 0 {
+			off := r.offset()
+			k, err := r.readBatchFixed(n-rows, rowWidth, cols)
+			rows += k
+			r.rows += int64(k)
+			if err != nil {
+				return rows, r.valueErr(0, off, err)
+			}
+			continue
+		}
+
+		j := 0
+		for i := range r.columns {
+			off := r.offset()
+			if r.skipped != nil && r.skipped[i] {
+				if err := Skip(r.wrap, r.columns[i].tp); err != nil {
+					return rows, r.valueErr(i, off, err)
+				}
+				continue
+			}
+			if err := cols[j].batchScan(r.wrap); err != nil {
+				return rows, r.valueErr(i, off, err)
+			}
+			j++
+		}
+		rows++
+		r.rows++
+		r.limit.rowBytes = 0
+	}
+	return rows, r.Err()
+}
+
+// readBatchFixed decodes up to n rows available in the read buffer
+func (r *FormatReader) readBatchFixed(n int, rowWidth int, cols []BatchColumn) (int, error) {
+	k := min(n, max(1, batchChunkSize/rowWidth))
+	if r.limit.maxRow > 0 {
+		// rows of chunk are counted as one
+		if int64(rowWidth) > r.limit.maxRow {
+			return 0, LimitError{Limit: "MaxRowBytes", Value: uint64(rowWidth), Max: r.limit.maxRow}
+		}
+		r.limit.rowBytes = 0
+		k = min(k, max(1, int(r.limit.maxRow)/rowWidth))
+	}
+	b, err := r.wrap.Peek(k * rowWidth)
+	if len(b) < rowWidth {
+		if err == nil || err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	k = len(b) / rowWidth
+	offset := 0
+	for _, col := range cols {
+		col.batchAppendFixed(b, k, rowWidth, offset)
+		offset += col.batchFixedWidth()
+	}
+
+	_, err = r.wrap.Discard(k * rowWidth)
+	r.limit.rowBytes = 0
+	return k, err
+}
+
+// WriteColumns writes rows from column buffers, one buffer for every configured column in order.
+// All buffers must have the same number of values.
+//
+// If all columns are fixed-width numbers (integers and floats) and format is not Native
+// or RowBinaryWithDefaults, rows are encoded into chunks without per-value Write calls.
+// With WithNilAsDefault nil values of Nullable columns are written as defaults like in WriteValue.
+func (w *FormatWriter) WriteColumns(cols ...BatchColumn) error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if len(cols) != len(w.options.columns) {
+		return w.setErr(fmt.Errorf("got %d batch columns, expected %d", len(cols), len(w.options.columns)))
+	}
+	if w.index != 0 {
+		return w.setErr(errors.New("batch must start at the beginning of row"))
+	}
+
+	rows := cols[0].batchLen()
+	rowWidth := 0
+	for i, col := range cols {
+		if tp := col.batchType(); tp.ID() != w.options.columns[i].tp.ID() {
+			return w.setErr(fmt.Errorf("type mismatch for column %s. expected %s, got %s", w.options.columns[i].name, w.options.columns[i].tp.String(), tp.String()))
+		}
+		if n := col.batchLen(); n != rows {
+			return w.setErr(fmt.Errorf("column %s has %d values, expected %d", w.options.columns[i].name, n, rows))
+		}
+		if width := col.batchFixedWidth(); width > 0 && rowWidth >= 0 {
+			rowWidth += width
+		} else {
+			rowWidth = -1
+		}
+	}
+
+	if rowWidth > 0 && w.native == nil && w.options.format != RowBinaryWithDefaults {
+		return w.writeColumnsFixed(rows, rowWidth, cols)
+	}
+
+	for i := range rows {
+		if err := w.check(); err != nil {
+			return err
+		}
+		for j, col := range cols {
+			if w.options.nilAsDefault && w.useDefault(w.options.columns[j].tp, col.batchValue(i)) {
+				if err := w.writeDefault(); err != nil {
+					return err
+				}
+				continue
+			}
+			off := w.offset()
+			if err := w.writeValuePrefix(); err != nil {
+				return err
+			}
+			if err := col.batchWrite(w.wrap, i); err != nil {
+				return w.valueErr(j, off, err)
+			}
+			w.nextColumn()
+		}
+	}
+	return nil
+}
+
+// writeColumnsFixed encodes rows by chunks of batchChunkSize
+func (w *FormatWriter) writeColumnsFixed(rows int, rowWidth int, cols []BatchColumn) error {
+	chunk := max(1, batchChunkSize/rowWidth)
+	b := make([]byte, min(rows, chunk)*rowWidth)
+	for i := 0; i < rows; i += chunk {
+		k := min(rows-i, chunk)
+		offset := 0
+		for _, col := range cols {
+			col.batchPutFixed(b, i, k, rowWidth, offset)
+			offset += col.batchFixedWidth()
+		}
+		off := w.offset()
+		if _, err := w.wrap.Write(b[:k*rowWidth]); err != nil {
+			return w.valueErr(0, off, err)
+		}
+		w.rows += int64(k)
+	}
+	return nil
+}
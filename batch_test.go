@@ -0,0 +1,148 @@
+package rowbinary
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_ReadBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	const total = 10000
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithNamesAndTypes, C("a", UInt64), C("b", Int16), C("c", Float64))
+	for i := range total {
+		assert.NoError(w.WriteAny(uint64(i), int16(-i), float64(i)/2))
+	}
+	data := buf.Bytes()
+
+	// small bufio buffer splits fixed-width chunks
+	r := NewFormatReader(bufio.NewReaderSize(bytes.NewReader(data), 100), RowBinaryWithNamesAndTypes)
+	a := NewColumnBuffer(UInt64, 0)
+	b := NewColumnBuffer(Int16, 0)
+	c := NewColumnBuffer(Float64, 0)
+	read := 0
+	for {
+		n, err := r.ReadBatch(3000, a, b, c)
+		assert.NoError(err)
+		if n == 0 {
+			break
+		}
+		assert.Equal(n, len(a.Values))
+		assert.Equal(n, len(c.Values))
+		for i := range n {
+			assert.Equal(uint64(read+i), a.Values[i])
+			assert.Equal(int16(-(read + i)), b.Values[i])
+			assert.Equal(float64(read+i)/2, c.Values[i])
+		}
+		read += n
+	}
+	assert.Equal(total, read)
+}
+
+func TestFormatReader_ReadBatchScan(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, Native, C("n", UInt32), C("s", String))
+	for i := range 5 {
+		assert.NoError(w.WriteAny(uint32(i), fmt.Sprint(i)))
+	}
+	assert.NoError(w.Flush())
+
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), Native)
+	n := NewColumnBuffer(UInt32, 2)
+	s := NewColumnBuffer(String, 2)
+
+	cnt, err := r.ReadBatch(3, n, s)
+	assert.NoError(err)
+	assert.Equal(3, cnt)
+	assert.Equal([]uint32{0, 1, 2}, n.Values)
+	assert.Equal([]string{"0", "1", "2"}, s.Values)
+
+	cnt, err = r.ReadBatch(3, n, s)
+	assert.NoError(err)
+	assert.Equal(2, cnt)
+	assert.Equal([]string{"3", "4"}, s.Values)
+
+	cnt, err = r.ReadBatch(3, n, s)
+	assert.NoError(err)
+	assert.Equal(0, cnt)
+
+	r = NewFormatReader(bytes.NewReader(buf.Bytes()), Native)
+	_, err = r.ReadBatch(3, NewColumnBuffer(UInt64, 0), s)
+	assert.ErrorContains(err, "type mismatch")
+}
+
+func TestFormatWriter_WriteColumns(t *testing.T) {
+	assert := assert.New(t)
+
+	const total = 10000
+	a := make([]uint64, total)
+	b := make([]int16, total)
+	c := make([]float64, total)
+	for i := range total {
+		a[i], b[i], c[i] = uint64(i), int16(-i), float64(i)/2
+	}
+
+	columns := []FormatOption{RowBinaryWithNamesAndTypes, C("a", UInt64), C("b", Int16), C("c", Float64)}
+	var expected bytes.Buffer
+	w := NewFormatWriter(&expected, columns...)
+	for i := range total {
+		assert.NoError(w.WriteAny(a[i], b[i], c[i]))
+	}
+
+	var buf bytes.Buffer
+	w = NewFormatWriter(&buf, columns...)
+	assert.NoError(w.WriteColumns(ColumnValues(UInt64, a), ColumnValues(Int16, b), ColumnValues(Float64, c)))
+	assert.Equal(expected.Bytes(), buf.Bytes())
+}
+
+func TestFormatWriter_WriteColumnsWrite(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, format := range []Format{RowBinary, RowBinaryWithDefaults, Native} {
+		var expected bytes.Buffer
+		w := NewFormatWriter(&expected, format, C("n", UInt32), C("s", String))
+		assert.NoError(w.WriteAny(uint32(1), "a", uint32(2), "b"))
+		assert.NoError(w.Flush())
+
+		var buf bytes.Buffer
+		w = NewFormatWriter(&buf, format, C("n", UInt32), C("s", String))
+		assert.NoError(w.WriteColumns(ColumnValues(UInt32, []uint32{1, 2}), ColumnValues(String, []string{"a", "b"})))
+		assert.NoError(w.Flush())
+		assert.Equal(expected.Bytes(), buf.Bytes(), format.String())
+	}
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, C("n", UInt32), C("s", String))
+	assert.ErrorContains(w.WriteColumns(ColumnValues(UInt32, []uint32{1, 2}), ColumnValues(String, []string{"a"})), "column s has 1 values, expected 2")
+
+	w = NewFormatWriter(&buf, C("n", UInt32), C("s", String))
+	assert.ErrorContains(w.WriteColumns(ColumnValues(UInt64, []uint64{1}), ColumnValues(String, []string{"a"})), "type mismatch")
+
+	w = NewFormatWriter(&buf, C("n", UInt32), C("s", String))
+	assert.ErrorContains(w.WriteColumns(ColumnValues(UInt32, []uint32{1})), "got 1 batch columns, expected 2")
+}
+
+func TestFormatWriter_WriteColumnsNilAsDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []*uint8{pointer(uint8(1)), nil}
+
+	var expected bytes.Buffer
+	w := NewFormatWriter(&expected, RowBinaryWithDefaults, WithNilAsDefault(true), C("n", UInt32), C("a", Nullable(UInt8)))
+	assert.NoError(w.WriteAny(uint32(1), values[0], uint32(2), values[1]))
+	assert.NoError(w.Flush())
+	assert.Equal([]byte{0, 1, 0, 0, 0, 0, 0, 1, 0, 2, 0, 0, 0, 1}, expected.Bytes())
+
+	var buf bytes.Buffer
+	w = NewFormatWriter(&buf, RowBinaryWithDefaults, WithNilAsDefault(true), C("n", UInt32), C("a", Nullable(UInt8)))
+	assert.NoError(w.WriteColumns(ColumnValues(UInt32, []uint32{1, 2}), ColumnValues(Nullable(UInt8), values)))
+	assert.NoError(w.Flush())
+	assert.Equal(expected.Bytes(), buf.Bytes())
+}
@@ -67,6 +67,41 @@ func BenchmarkRowbinary_Select_SystemNumbers_Any(b *testing.B) {
 	b.StopTimer()
 }
 
+func BenchmarkRowbinary_Select_SystemNumbers_Batch(b *testing.B) {
+	assert := assert.New(b)
+
+	ctx := context.Background()
+	c := rowbinary.NewClient(ctx, testClickHouseDSN, nil)
+
+	col := rowbinary.NewColumnBuffer(rowbinary.UInt64, 10000)
+
+	b.ResetTimer()
+
+	for b.Loop() {
+		assert.NoError(
+			c.Select(ctx, "SELECT * FROM system.numbers LIMIT 1000000",
+				rowbinary.C("number", rowbinary.UInt64),
+				rowbinary.WithFormatReader(func(r *rowbinary.FormatReader) error {
+					cnt := 0
+					for {
+						n, err := r.ReadBatch(10000, col)
+						if err != nil {
+							return err
+						}
+						if n == 0 {
+							break
+						}
+						cnt += n
+					}
+					assert.Equal(1000000, cnt)
+					return r.Err()
+				})),
+		)
+	}
+
+	b.StopTimer()
+}
+
 func BenchmarkNative_Select_SystemNumbers(b *testing.B) {
 	assert := assert.New(b)
 
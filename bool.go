@@ -1,5 +1,7 @@
 package rowbinary
 
+import "strconv"
+
 var Bool Type[bool] = MakeTypeWrapAny[bool](typeBool{})
 
 type typeBool struct{}
@@ -19,6 +21,13 @@ func (t typeBool) Write(w Writer, value bool) error {
 	return UInt8.Write(w, 0)
 }
 
+func (t typeBool) Append(dst []byte, v bool) ([]byte, error) {
+	if v {
+		return append(dst, 1), nil
+	}
+	return append(dst, 0), nil
+}
+
 func (t typeBool) Scan(r Reader, v *bool) error {
 	val, err := r.ReadByte()
 	if err != nil {
@@ -27,3 +36,31 @@ func (t typeBool) Scan(r Reader, v *bool) error {
 	*v = val == 1
 	return nil
 }
+
+func (t typeBool) Skip(r Reader) error {
+	return skipBytes(r, 1)
+}
+
+func (t typeBool) AppendLiteral(dst []byte, v bool) ([]byte, error) {
+	return strconv.AppendBool(dst, v), nil
+}
+
+func (t typeBool) ScanLiteral(s *LiteralScanner, v *bool) error {
+	w, err := s.Word()
+	if err != nil {
+		return err
+	}
+	switch w {
+	case "true", "TRUE", "1":
+		*v = true
+	case "false", "FALSE", "0":
+		*v = false
+	default:
+		return s.Errorf("invalid Bool value %q", w)
+	}
+	return nil
+}
+
+func (t typeBool) AppendJSON(dst []byte, v bool, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendBool(dst, v), nil
+}
@@ -0,0 +1,87 @@
+package rowbinary
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+)
+
+// borrowFixedLength returns length of FixedString values or 0 for String values of type tp.
+// ok is false if values of tp can't be borrowed
+func borrowFixedLength(tp Any) (length int, ok bool) {
+	bin := stripLowCardinality(tp.Binary())
+	if len(bin) == 0 {
+		return 0, false
+	}
+	switch [1]byte{bin[0]} {
+	case BinaryTypeString:
+		return 0, len(bin) == 1
+	case BinaryTypeFixedString:
+		n, k := binary.Uvarint(bin[1:])
+		return int(n), k > 0 && k == len(bin)-1
+	}
+	return 0, false
+}
+
+// BorrowBytes reads value of the current String or FixedString column without copying it.
+//
+// Returned slice points into the read buffer of r and is valid only until the next call of any method of r
+// (including Next), it must not be modified. Values larger than the read buffer are copied
+// to internal buffer of r with the same lifetime. Use Scan to get a value that may be retained.
+func (r *FormatReader) BorrowBytes() ([]byte, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
+	if err := r.skipUnprojected(); err != nil {
+		return nil, err
+	}
+
+	tp := r.columns[r.index].tp
+	length, ok := borrowFixedLength(tp)
+	if !ok {
+		return nil, r.valueErr(r.index, r.offset(), fmt.Errorf("borrowing is not supported for type %s", tp.String()))
+	}
+
+	off := r.offset()
+	if length == 0 {
+		n, err := readStringLen(r.wrap)
+		if err != nil {
+			return nil, r.valueErr(r.index, off, err)
+		}
+		length = n
+	}
+
+	b, err := r.borrow(length)
+	if err != nil {
+		return nil, r.valueErr(r.index, off, err)
+	}
+	// unprojected columns after this one are skipped by the next call, skipping now may overwrite b
+	r.nextColumn()
+	return b, nil
+}
+
+// BorrowString is like BorrowBytes but returns string sharing memory with the read buffer.
+// The string has the same lifetime as BorrowBytes result: its content changes after the next call of any method of r,
+// so it must not be retained or used as a map key. Convert it with strings.Clone to keep it.
+func (r *FormatReader) BorrowString() (string, error) {
+	b, err := r.BorrowBytes()
+	if len(b) == 0 {
+		return "", err
+	}
+	return unsafe.String(unsafe.SliceData(b), len(b)), err
+}
+
+// borrow returns next n bytes of stream without copying if they fit the read buffer
+func (r *FormatReader) borrow(n int) ([]byte, error) {
+	b, err := r.wrap.Peek(n)
+	if err == nil {
+		_, err = r.wrap.Discard(n)
+		return b, err
+	}
+	if err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	r.borrowBuf, err = readAppend(r.borrowBuf[:0], r.wrap, n)
+	return r.borrowBuf, err
+}
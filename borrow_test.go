@@ -0,0 +1,125 @@
+package rowbinary
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_Borrow(t *testing.T) {
+	assert := assert.New(t)
+
+	large := strings.Repeat("y", 100)
+	cols := []FormatOption{
+		C("id", UInt32),
+		C("s", String),
+		C("f", FixedString(3)),
+		C("lc", LowCardinality(String)),
+		C("tail", UInt64),
+	}
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, cols...)
+	for i := range 10 {
+		s := "value"
+		if i%2 == 1 {
+			s = large
+		}
+		assert.NoError(w.WriteRow(uint32(i), s, []byte("abc"), "lc", uint64(i)))
+	}
+	assert.NoError(w.Finish())
+	data := buf.Bytes()
+
+	src := bytes.NewReader(data)
+	r := NewFormatReader(src, append(cols, WithProjection("s", "f", "lc"), WithReadBufferSize(64))...)
+	var matched, total int
+	read := func() {
+		matched, total = 0, 0
+		src.Reset(data)
+		r.Reset(src)
+		for r.Next() {
+			s, err := r.BorrowString()
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := r.BorrowBytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			lc, err := r.BorrowBytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s == large && string(f) == "abc" && string(lc) == "lc" {
+				matched++
+			}
+			total++
+		}
+		if err := r.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read()
+	assert.Equal(5, matched)
+	assert.Equal(10, total)
+
+	// filter without projection doesn't allocate
+	r2 := NewFormatReader(src, append(cols, WithReadBufferSize(64))...)
+	filter := func() {
+		matched = 0
+		src.Reset(data)
+		r2.Reset(src)
+		for r2.Next() {
+			if err := r2.SkipColumn(); err != nil {
+				t.Fatal(err)
+			}
+			s, err := r2.BorrowBytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(s) == large {
+				matched++
+			}
+			if err := r2.SkipRow(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := r2.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	filter()
+	assert.Equal(5, matched)
+	assert.Equal(0.0, testing.AllocsPerRun(10, filter))
+
+	// not string column
+	r = NewFormatReader(bytes.NewReader(data), cols...)
+	assert.True(r.Next())
+	_, err := r.BorrowBytes()
+	assert.ErrorContains(err, "borrowing is not supported for type UInt32")
+}
+
+func TestBorrowFixedLength(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, c := range []struct {
+		tp     Any
+		length int
+		ok     bool
+	}{
+		{String, 0, true},
+		{StringBytes, 0, true},
+		{FixedString(16), 16, true},
+		{LowCardinality(String), 0, true},
+		{Nullable(String), 0, false},
+		{Array(String), 0, false},
+		{UInt8, 0, false},
+	} {
+		length, ok := borrowFixedLength(c.tp)
+		assert.Equal(c.length, length, c.tp.String())
+		assert.Equal(c.ok, ok, c.tp.String())
+	}
+}
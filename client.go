@@ -28,6 +28,8 @@ type clientOptions struct {
 	defaultSelect []SelectOption
 	defaultInsert []InsertOption
 	defaultExec   []ExecOption
+	noPool        bool
+	pool          *bufferPool // nil if pooling is disabled
 }
 
 type ClientOption interface {
@@ -97,11 +99,35 @@ type Client interface {
 	// configuration are applied first. The format header is automatically written before invoking writeFunc.
 	Insert(ctx context.Context, table string, options ...InsertOption) error
 
+	// InsertStream starts an INSERT query into the specified table receiving rows from concurrent producers.
+	//
+	// Every goroutine writes rows with its own InsertProducer created by InsertStream.NewProducer. Producers encode
+	// whole rows into own buffers, buffered rows are merged into the request body at row boundaries.
+	// Producers are blocked while the request doesn't keep up with them.
+	//
+	// Parameters:
+	//   - ctx: Context for the request, used for cancellation and timeouts.
+	//   - table: The name of the ClickHouse table to insert data into.
+	//   - options: Optional InsertOption values to configure the insert (e.g., columns, format, params, headers).
+	//
+	// Returns:
+	//   - *InsertStream: The stream handle. InsertStream.Close finishes the request and returns its result.
+	//
+	// Note: Producers encode rows as RowBinary, the request may use any insert format. With RowBinaryWithDefaults
+	// producers encode rows in this format, so WithNilAsDefault applies to them.
+	InsertStream(ctx context.Context, table string, options ...InsertOption) *InsertStream
+
 	Close() error
 }
 
 var _ ClientOption = WithUseBinaryHeader(false)
+var _ ClientOption = WithLenientWriteAny(false)
+var _ ClientOption = WithNilAsDefault(false)
 var _ ClientOption = RowBinary
+var _ ClientOption = SchemaTolerant
+var _ ClientOption = WithReadBufferSize(0)
+var _ ClientOption = WithWriteBufferSize(0)
+var _ ClientOption = WithBufferPooling(true)
 var _ ClientOption = WithParam("key", "value")
 var _ ClientOption = WithHeader("key", "value")
 var _ ClientOption = WithDatabase("default")
@@ -145,6 +171,20 @@ func WithDiscovery(discovery func(ctx context.Context, dsn string, kind Discover
 	return clientOptionDiscovery{discovery: discovery}
 }
 
+type clientOptionBufferPooling struct {
+	enabled bool
+}
+
+func (o clientOptionBufferPooling) applyClientOptions(opts *clientOptions) {
+	opts.noPool = !o.enabled
+}
+
+// WithBufferPooling enables or disables reuse of read and write buffers between requests, enabled by default.
+// With pooling FormatReader passed to WithFormatReader callback must not be used after the callback returns.
+func WithBufferPooling(enabled bool) ClientOption {
+	return clientOptionBufferPooling{enabled: enabled}
+}
+
 // NewClient creates a new ClickHouse client.
 func NewClient(ctx context.Context, options ...ClientOption) Client {
 	opts := clientOptions{}
@@ -160,6 +200,10 @@ func NewClient(ctx context.Context, options ...ClientOption) Client {
 
 	c := &client{opts: opts}
 
+	if !c.opts.noPool {
+		c.opts.pool = newBufferPool()
+	}
+
 	if c.opts.httpClient == nil {
 		c.opts.httpClient = getGlobalHTTPClient()
 	}
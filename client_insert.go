@@ -1,7 +1,6 @@
 package rowbinary
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -9,13 +8,14 @@ import (
 )
 
 type insertOptions struct {
-	dsn           string
-	formatOptions []FormatOption
-	format        Format
-	params        map[string]string
-	headers       map[string]string
-	formatWriter  func(w *FormatWriter) error
-	bodyWriter    func(w io.Writer) error
+	dsn             string
+	formatOptions   []FormatOption
+	format          Format
+	params          map[string]string
+	headers         map[string]string
+	formatWriter    func(w *FormatWriter) error
+	bodyWriter      func(w io.Writer) error
+	writeBufferSize int
 }
 
 type InsertOption interface {
@@ -24,10 +24,14 @@ type InsertOption interface {
 
 var _ InsertOption = C("", nil)
 var _ InsertOption = WithUseBinaryHeader(false)
+var _ InsertOption = WithLenientWriteAny(false)
+var _ InsertOption = WithNilAsDefault(false)
 var _ InsertOption = RowBinary
 var _ InsertOption = WithParam("key", "value")
 var _ InsertOption = WithHeader("key", "value")
 var _ InsertOption = WithDSN("http://localhost:8123")
+var _ InsertOption = WithColumnValues()
+var _ InsertOption = WithWriteBufferSize(0)
 
 func (c *client) Insert(ctx context.Context, table string, options ...InsertOption) error {
 	opts := insertOptions{
@@ -54,7 +58,8 @@ func (c *client) Insert(ctx context.Context, table string, options ...InsertOpti
 
 	go func() {
 		defer w.Close()
-		bw := bufio.NewWriterSize(w, 1024*1024)
+		bw := c.opts.pool.getWriter(w, opts.writeBufferSize)
+		defer c.opts.pool.putWriter(bw)
 		defer bw.Flush()
 
 		if opts.formatWriter != nil {
@@ -69,6 +74,11 @@ func (c *client) Insert(ctx context.Context, table string, options ...InsertOpti
 				return
 			}
 
+			if err := writer.Finish(); err != nil {
+				_ = w.CloseWithError(err)
+				return
+			}
+
 			return
 		}
 
@@ -126,3 +136,27 @@ func WithBodyWriter(bw func(w io.Writer) error) InsertOption {
 func (o bodyWriterOption) applyInsertOptions(opts *insertOptions) {
 	opts.bodyWriter = o.bodyWriter
 }
+
+// WithColumnValues writes rows from column buffers, see FormatWriter.WriteColumns.
+func WithColumnValues(cols ...BatchColumn) formatWriterOption {
+	return WithFormatWriter(func(w *FormatWriter) error {
+		return w.WriteColumns(cols...)
+	})
+}
+
+type writeBufferSizeOption struct {
+	size int
+}
+
+// WithWriteBufferSize sets size of buffer used to write the Insert request body, default is DefaultWriteBufferSize.
+func WithWriteBufferSize(size int) writeBufferSizeOption {
+	return writeBufferSizeOption{size: size}
+}
+
+func (o writeBufferSizeOption) applyInsertOptions(opts *insertOptions) {
+	opts.writeBufferSize = o.size
+}
+
+func (o writeBufferSizeOption) applyClientOptions(opts *clientOptions) {
+	opts.defaultInsert = append(opts.defaultInsert, o)
+}
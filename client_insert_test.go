@@ -43,3 +43,72 @@ func TestClient_Insert(t *testing.T) {
 	), "insertion failed")
 
 }
+
+func TestClient_InsertWithDefaults(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	c := NewTestClient(ctx, testClickHouseDSN)
+	defer c.Close()
+
+	assert.NoError(c.Exec(ctx, "CREATE TABLE t1 (x UInt32, y String DEFAULT toString(x), z Nullable(String) DEFAULT 'z') ENGINE = Memory"))
+
+	assert.NoError(c.Insert(ctx,
+		"t1",
+		C("x", UInt32), C("y", String), C("z", Nullable(String)),
+		RowBinaryWithDefaults,
+		WithNilAsDefault(true),
+		WithFormatWriter(func(w *FormatWriter) error {
+			if err := w.WriteAny(uint32(1), "a", pointer("b")); err != nil {
+				return err
+			}
+			if err := Write(w, UInt32, 2); err != nil {
+				return err
+			}
+			if err := w.WriteDefault(); err != nil {
+				return err
+			}
+			return Write(w, Nullable(String), nil)
+		}),
+	))
+
+	var rows []string
+	assert.NoError(c.Select(ctx, "SELECT concat(y, '-', z) FROM t1 ORDER BY x", C("v", String), WithFormatReader(func(r *FormatReader) error {
+		for r.Next() {
+			var v string
+			if err := Scan(r, String, &v); err != nil {
+				return err
+			}
+			rows = append(rows, v)
+		}
+		return r.Err()
+	})))
+	assert.Equal([]string{"a-b", "2-z"}, rows)
+}
+
+func TestClient_InsertColumnValues(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	c := NewTestClient(ctx, testClickHouseDSN)
+	defer c.Close()
+
+	assert.NoError(c.Exec(ctx, "CREATE TABLE t1 (x UInt32, y String) ENGINE = Memory"))
+
+	assert.NoError(c.Insert(ctx,
+		"t1",
+		C("x", UInt32), C("y", String),
+		WithColumnValues(ColumnValues(UInt32, []uint32{1, 2}), ColumnValues(String, []string{"a", "b"})),
+	))
+
+	var rows []string
+	assert.NoError(c.Select(ctx, "SELECT concat(toString(x), '-', y) FROM t1 ORDER BY x", C("v", String), WithFormatReader(func(r *FormatReader) error {
+		for r.Next() {
+			var v string
+			if err := Scan(r, String, &v); err != nil {
+				return err
+			}
+			rows = append(rows, v)
+		}
+		return r.Err()
+	})))
+	assert.Equal([]string{"1-a", "2-b"}, rows)
+}
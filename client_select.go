@@ -1,7 +1,6 @@
 package rowbinary
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -11,13 +10,14 @@ import (
 )
 
 type selectOptions struct {
-	dsn           string
-	formatOptions []FormatOption
-	externalData  []externalData
-	params        map[string]string
-	headers       map[string]string
-	formatReader  func(r *FormatReader) error
-	bodyReader    func(r io.Reader) error
+	dsn            string
+	formatOptions  []FormatOption
+	readBufferSize int
+	externalData   []externalData
+	params         map[string]string
+	headers        map[string]string
+	formatReader   func(ctx context.Context, r *FormatReader) error
+	bodyReader     func(r io.Reader) error
 }
 
 type SelectOption interface {
@@ -40,12 +40,16 @@ HEADER: Keep-Alive [timeout=30, max=9999]
 
 var _ SelectOption = C("", nil)
 var _ SelectOption = WithUseBinaryHeader(false)
+var _ SelectOption = WithProjection()
+var _ SelectOption = SchemaTolerant
+var _ SelectOption = WithReadBufferSize(0)
 var _ SelectOption = RowBinary
 var _ SelectOption = WithParam("key", "value")
 var _ SelectOption = WithHeader("key", "value")
 var _ SelectOption = WithExternalData("key")
 var _ SelectOption = WithFormatReader(nil)
 var _ SelectOption = WithBodyReader(nil)
+var _ SelectOption = WithParallelRows(ParallelConfig{}, func(ctx context.Context, row *Row) (struct{}, error) { return struct{}{}, nil }, nil)
 var _ SelectOption = WithDSN("http://localhost:8123")
 
 func (c *client) Select(ctx context.Context, query string, options ...SelectOption) error {
@@ -60,6 +64,10 @@ func (c *client) Select(ctx context.Context, query string, options ...SelectOpti
 		opt.applySelectOptions(&opts)
 	}
 
+	// canceled to stop reading response on error of parallel processing
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	req, err := c.newRequest(ctx, opts.dsn, DiscoveryCtx{Method: ClientMethodSelect}, opts.params, opts.headers)
 	if err != nil {
 		return err
@@ -75,7 +83,8 @@ func (c *client) Select(ctx context.Context, query string, options ...SelectOpti
 
 		go func() {
 			defer w.Close()
-			bw := bufio.NewWriterSize(w, 1024*1024)
+			bw := c.opts.pool.getWriter(w, DefaultWriteBufferSize)
+			defer c.opts.pool.putWriter(bw)
 			defer bw.Flush()
 			mw := multipart.NewWriter(bw)
 			mw.SetBoundary(tmpWriter.Boundary())
@@ -113,6 +122,11 @@ func (c *client) Select(ctx context.Context, query string, options ...SelectOpti
 					w.CloseWithError(err)
 					return
 				}
+
+				if err := fw.Finish(); err != nil {
+					w.CloseWithError(err)
+					return
+				}
 			}
 
 			if err := mw.Close(); err != nil {
@@ -143,8 +157,12 @@ func (c *client) Select(ctx context.Context, query string, options ...SelectOpti
 	// defer px.Close()
 
 	if opts.formatReader != nil {
-		fr := NewFormatReader(resp.Body, opts.formatOptions...)
-		if err := opts.formatReader(fr); err != nil {
+		br := c.opts.pool.getReader(resp.Body, opts.readBufferSize)
+		defer c.opts.pool.putReader(br)
+
+		fr := NewFormatReader(br, opts.formatOptions...)
+		fr.abort = cancel
+		if err := opts.formatReader(ctx, fr); err != nil {
 			return err
 		}
 		return nil
@@ -161,11 +179,16 @@ func (c *client) Select(ctx context.Context, query string, options ...SelectOpti
 }
 
 func WithFormatReader(cb func(r *FormatReader) error) SelectOption {
-	return formatReaderOption{cb: cb}
+	if cb == nil {
+		return formatReaderOption{}
+	}
+	return formatReaderOption{cb: func(_ context.Context, r *FormatReader) error {
+		return cb(r)
+	}}
 }
 
 type formatReaderOption struct {
-	cb func(r *FormatReader) error
+	cb func(ctx context.Context, r *FormatReader) error
 }
 
 func (f formatReaderOption) applySelectOptions(opts *selectOptions) {
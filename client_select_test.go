@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -211,3 +213,98 @@ func TestClient_Select_InvalidDSN(t *testing.T) {
 	// Should contain connection error
 	// assert.Contains(err.Error(), "context deadline exceeded") // or similar connection error
 }
+
+func TestClient_SelectNative(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	c := NewTestClient(ctx, testClickHouseDSN)
+	defer c.Close()
+
+	type row struct {
+		n uint64
+		s *string
+		a []string
+		m map[string]uint64
+	}
+	var rows []row
+	assert.NoError(c.Select(ctx,
+		`SELECT
+			number AS n,
+			if(number % 2 = 0, NULL, toString(number)) AS s,
+			arrayMap(x -> toLowCardinality(toString(x)), range(number)) AS a,
+			map('k', number) AS m
+		FROM system.numbers LIMIT 3`,
+		Native,
+		C("s", Nullable(String)),
+		C("a", Array(LowCardinality(String))),
+		C("m", Map(String, UInt64)),
+		WithFormatReader(func(r *FormatReader) error {
+			for r.Next() {
+				var v row
+				if err := r.Scan(&v.n, &v.s, &v.a, &v.m); err != nil {
+					return err
+				}
+				rows = append(rows, v)
+			}
+			return r.Err()
+		}),
+	))
+	assert.Equal([]row{
+		{0, nil, []string{}, map[string]uint64{"k": 0}},
+		{1, pointer("1"), []string{"0"}, map[string]uint64{"k": 1}},
+		{2, nil, []string{"0", "1"}, map[string]uint64{"k": 2}},
+	}, rows)
+
+	assert.NoError(c.Exec(ctx, "CREATE TABLE t_native (x UInt32, s LowCardinality(Nullable(String)), a Array(String)) ENGINE = Memory"))
+	assert.NoError(c.Insert(ctx, "t_native",
+		Native,
+		C("x", UInt32), C("s", LowCardinality(Nullable(String))), C("a", Array(String)),
+		WithFormatWriter(func(w *FormatWriter) error {
+			for i := range 3 {
+				var s *string
+				if i > 0 {
+					s = pointer("v")
+				}
+				if err := w.WriteAny(uint32(i), s, []string{"a"}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+	))
+
+	var count uint64
+	assert.NoError(c.Select(ctx, "SELECT countIf(s = 'v' AND a = ['a']) FROM t_native", WithFormatReader(func(r *FormatReader) error {
+		for r.Next() {
+			if err := Scan(r, UInt64, &count); err != nil {
+				return err
+			}
+		}
+		return r.Err()
+	})))
+	assert.Equal(uint64(2), count)
+}
+
+func TestWithFormatReader_Nil(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte("42"))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(ctx, WithDSN(srv.URL))
+
+	// nil callback doesn't override body reader
+	var body []byte
+	assert.NoError(c.Select(ctx, "SELECT 42",
+		WithBodyReader(func(r io.Reader) error {
+			var err error
+			body, err = io.ReadAll(r)
+			return err
+		}),
+		WithFormatReader(nil),
+	))
+	assert.Equal("42", string(body))
+}
@@ -0,0 +1,274 @@
+package rowbinary
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// coercer is implemented by types that can convert loosely typed values
+// (other numeric kinds, pointers, string/[]byte, Unix timestamps) to T.
+type coercer[T any] interface {
+	coerce(v any) (T, error)
+}
+
+// anyCoercer is implemented by typeWrapper and used by the lenient WriteAny path.
+type anyCoercer interface {
+	coerceAny(v any) (any, error)
+}
+
+// Coerce converts v to the Go type of tp.
+//
+// Values that already have type T are returned as is. Otherwise the value is converted
+// between Go numeric kinds (with range checks), between string and []byte, between
+// time.Time and Unix timestamps, and pointers are dereferenced. Composite types
+// (Array, Map, Nullable, Tuple) coerce their elements recursively.
+func Coerce[T any](tp Type[T], v any) (T, error) {
+	if c, ok := tp.(coercer[T]); ok {
+		return c.coerce(v)
+	}
+	if value, ok := v.(T); ok {
+		return value, nil
+	}
+	var zero T
+	return zero, newTypeMismatchError[T](v)
+}
+
+// WriteAnyLenient writes v using tp, converting it to the type expected by tp with Coerce rules.
+func WriteAnyLenient(w Writer, tp Any, v any) error {
+	value, err := coerceAny(tp, v)
+	if err != nil {
+		return err
+	}
+	return tp.WriteAny(w, value)
+}
+
+func coerceAny(tp Any, v any) (any, error) {
+	if c, ok := tp.(anyCoercer); ok {
+		return c.coerceAny(v)
+	}
+	return v, nil
+}
+
+func newTypeMismatchError[T any](v any) TypeMismatchError {
+	return TypeMismatchError{
+		ExpectedType: reflect.TypeFor[T]().String(),
+		ActualType:   fmt.Sprintf("%T", v),
+	}
+}
+
+func (t *typeWrapper[T]) coerce(v any) (T, error) {
+	if c, ok := t.PreType.(coercer[T]); ok {
+		return c.coerce(v)
+	}
+	if value, ok := v.(T); ok {
+		return value, nil
+	}
+	var zero T
+	return zero, newTypeMismatchError[T](v)
+}
+
+func (t *typeWrapper[T]) coerceAny(v any) (any, error) {
+	return t.coerce(v)
+}
+
+func (t typeWrapperAny[T]) coerce(v any) (T, error) {
+	if c, ok := t.BaseType.(coercer[T]); ok {
+		return c.coerce(v)
+	}
+	if value, ok := v.(T); ok {
+		return value, nil
+	}
+	return coerceValue[T](v)
+}
+
+func (t *customType[T]) coerce(v any) (T, error) {
+	return Coerce(t.Type, v)
+}
+
+// coerceValue converts scalar values to T
+func coerceValue[T any](v any) (T, error) {
+	var ret T
+	src, ok := coerceDeref(v)
+	if !ok {
+		return ret, newTypeMismatchError[T](v)
+	}
+
+	dst := reflect.ValueOf(&ret).Elem()
+	if src.Type().ConvertibleTo(dst.Type()) && src.Kind() == dst.Kind() {
+		dst.Set(src.Convert(dst.Type()))
+		return ret, nil
+	}
+
+	switch p := any(&ret).(type) {
+	case *time.Time:
+		tm, ok := coerceTime(src)
+		if !ok {
+			return ret, newTypeMismatchError[T](v)
+		}
+		*p = tm
+		return ret, nil
+	case *ValueDate:
+		tm, ok := coerceTime(src)
+		if !ok {
+			return ret, newTypeMismatchError[T](v)
+		}
+		*p = ValueDate{Year: uint16(tm.Year()), Month: uint8(tm.Month()), Day: uint8(tm.Day())}
+		return ret, nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := coerceInt(src)
+		if !ok {
+			return ret, newTypeMismatchError[T](v)
+		}
+		if dst.OverflowInt(n) {
+			return ret, fmt.Errorf("value %v out of range for %s", v, dst.Type())
+		}
+		dst.SetInt(n)
+		return ret, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := coerceUint(src)
+		if !ok {
+			if _, isInt := coerceInt(src); isInt {
+				return ret, fmt.Errorf("value %v out of range for %s", v, dst.Type())
+			}
+			return ret, newTypeMismatchError[T](v)
+		}
+		if dst.OverflowUint(n) {
+			return ret, fmt.Errorf("value %v out of range for %s", v, dst.Type())
+		}
+		dst.SetUint(n)
+		return ret, nil
+	case reflect.Float32, reflect.Float64:
+		f, ok := coerceFloat(src)
+		if !ok {
+			return ret, newTypeMismatchError[T](v)
+		}
+		if dst.OverflowFloat(f) {
+			return ret, fmt.Errorf("value %v out of range for %s", v, dst.Type())
+		}
+		dst.SetFloat(f)
+		return ret, nil
+	case reflect.String:
+		if src.Kind() == reflect.Slice && src.Type().Elem().Kind() == reflect.Uint8 {
+			dst.SetString(string(src.Bytes()))
+			return ret, nil
+		}
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() == reflect.Uint8 && src.Kind() == reflect.String {
+			dst.SetBytes([]byte(src.String()))
+			return ret, nil
+		}
+	}
+
+	return ret, newTypeMismatchError[T](v)
+}
+
+// coerceDeref returns the value behind any number of pointers
+func coerceDeref(v any) (reflect.Value, bool) {
+	src := reflect.ValueOf(v)
+	for src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			return src, false
+		}
+		src = src.Elem()
+	}
+	return src, src.IsValid()
+}
+
+func coerceInt(src reflect.Value) (int64, bool) {
+	switch src.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return src.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if src.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(src.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := src.Float()
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(f), true
+	}
+	if tm, ok := src.Interface().(time.Time); ok {
+		return tm.Unix(), true
+	}
+	return 0, false
+}
+
+func coerceUint(src reflect.Value) (uint64, bool) {
+	switch src.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return src.Uint(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if src.Int() < 0 {
+			return 0, false
+		}
+		return uint64(src.Int()), true
+	case reflect.Float32, reflect.Float64:
+		f := src.Float()
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+			return 0, false
+		}
+		return uint64(f), true
+	}
+	if tm, ok := src.Interface().(time.Time); ok && tm.Unix() >= 0 {
+		return uint64(tm.Unix()), true
+	}
+	return 0, false
+}
+
+func coerceFloat(src reflect.Value) (float64, bool) {
+	switch src.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return src.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(src.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(src.Uint()), true
+	}
+	return 0, false
+}
+
+// coerceTime accepts time.Time and Unix timestamps in seconds
+func coerceTime(src reflect.Value) (time.Time, bool) {
+	if tm, ok := src.Interface().(time.Time); ok {
+		return tm, true
+	}
+	switch src.Kind() {
+	case reflect.Float32, reflect.Float64:
+		sec, frac := math.Modf(src.Float())
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), true
+	}
+	if n, ok := coerceInt(src); ok {
+		return time.Unix(n, 0).UTC(), true
+	}
+	return time.Time{}, false
+}
+
+// coerceElems returns elements of a slice or array as a new []any
+func coerceElems(v any) ([]any, bool) {
+	src, ok := coerceDeref(v)
+	if !ok || (src.Kind() != reflect.Slice && src.Kind() != reflect.Array) {
+		return nil, false
+	}
+	ret := make([]any, src.Len())
+	for i := range ret {
+		ret[i] = src.Index(i).Interface()
+	}
+	return ret, true
+}
+
+// coerceIsNil reports whether v is nil or a nil pointer
+func coerceIsNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	src := reflect.ValueOf(v)
+	return src.Kind() == reflect.Pointer && src.IsNil()
+}
@@ -0,0 +1,90 @@
+package rowbinary
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoerce(t *testing.T) {
+	assert := assert.New(t)
+
+	u8, err := Coerce(UInt8, 5)
+	assert.NoError(err)
+	assert.Equal(uint8(5), u8)
+
+	_, err = Coerce(UInt8, 256)
+	assert.ErrorContains(err, "out of range")
+
+	_, err = Coerce(UInt32, -1)
+	assert.ErrorContains(err, "out of range")
+
+	i64, err := Coerce(Int64, pointer(float64(42)))
+	assert.NoError(err)
+	assert.Equal(int64(42), i64)
+
+	_, err = Coerce(Int64, 4.2)
+	assert.Error(err)
+
+	s, err := Coerce(String, []byte("hello"))
+	assert.NoError(err)
+	assert.Equal("hello", s)
+
+	b, err := Coerce(StringBytes, "hello")
+	assert.NoError(err)
+	assert.Equal([]byte("hello"), b)
+
+	tm, err := Coerce(DateTime, int64(1700000000))
+	assert.NoError(err)
+	assert.Equal(time.Unix(1700000000, 0).UTC(), tm)
+
+	ts, err := Coerce(UInt32, time.Unix(1700000000, 0))
+	assert.NoError(err)
+	assert.Equal(uint32(1700000000), ts)
+
+	arr, err := Coerce(Array(UInt16), []int{1, 2, 3})
+	assert.NoError(err)
+	assert.Equal([]uint16{1, 2, 3}, arr)
+
+	mp, err := Coerce(Map(String, Float64), map[string]int{"a": 1})
+	assert.NoError(err)
+	assert.Equal(map[string]float64{"a": 1}, mp)
+
+	n, err := Coerce(Nullable(Int32), 42)
+	assert.NoError(err)
+	assert.Equal(pointer(int32(42)), n)
+
+	n, err = Coerce(Nullable(Int32), (*int)(nil))
+	assert.NoError(err)
+	assert.Nil(n)
+
+	tuple, err := Coerce(TupleAny(UInt8, String), []any{1, []byte("x")})
+	assert.NoError(err)
+	assert.Equal([]any{uint8(1), "x"}, tuple)
+
+	_, err = Coerce(UInt8, "5")
+	var mismatch TypeMismatchError
+	assert.True(errors.As(err, &mismatch))
+	assert.Equal(TypeMismatchError{ExpectedType: "uint8", ActualType: "string"}, mismatch)
+}
+
+func TestFormatWriter_WriteAnyLenient(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, C("a", UInt8), C("b", Nullable(String)))
+	err := w.WriteAny(5, "x")
+	var mismatch TypeMismatchError
+	assert.True(errors.As(err, &mismatch))
+	assert.Equal("uint8", mismatch.ExpectedType)
+	assert.Equal("int", mismatch.ActualType)
+
+	buf.Reset()
+	w = NewFormatWriter(&buf, C("a", UInt8), C("b", Nullable(String)), WithLenientWriteAny(true))
+	assert.NoError(w.WriteAny(5, "x"))
+	assert.NoError(w.WriteAny(uint64(7), nil))
+	assert.Equal([]byte{5, 0, 1, 'x', 7, 1}, buf.Bytes())
+}
@@ -5,6 +5,7 @@ var _ FormatOption = WithColumn("", UInt8)
 type Column struct {
 	name string
 	tp   Any
+	def  any // value for column missing in result in SchemaTolerant mode
 }
 
 type Columns struct {
@@ -50,6 +51,17 @@ func (c Column) Type() Any {
 	return c.tp
 }
 
+// WithDefault returns copy of column with value used when column is missing in result in SchemaTolerant mode.
+func (c Column) WithDefault(v any) Column {
+	c.def = v
+	return c
+}
+
+// Default returns value set with WithDefault.
+func (c Column) Default() any {
+	return c.def
+}
+
 func (c Column) String() string {
 	return c.Name() + " " + c.Type().String()
 }
@@ -1,6 +1,7 @@
 package rowbinary
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 )
@@ -30,6 +31,15 @@ func (t typeDate) Write(w Writer, value ValueDate) error {
 	return UInt16.Write(w, days)
 }
 
+func (t typeDate) Append(dst []byte, v ValueDate) ([]byte, error) {
+	if v.Year < 1970 {
+		return dst, fmt.Errorf("invalid date: %#v", v)
+	}
+
+	tm := time.Date(int(v.Year), time.Month(v.Month), int(v.Day), 0, 0, 0, 0, time.UTC)
+	return binary.LittleEndian.AppendUint16(dst, uint16(tm.Unix()/secInDay)), nil
+}
+
 func (t typeDate) Scan(r Reader, v *ValueDate) error {
 	var n uint16
 	err := UInt16.Scan(r, &n)
@@ -42,3 +52,45 @@ func (t typeDate) Scan(r Reader, v *ValueDate) error {
 	v.Day = uint8(tm.Day())
 	return nil
 }
+
+func (t typeDate) Skip(r Reader) error {
+	return skipBytes(r, 2)
+}
+
+func (t typeDate) AppendLiteral(dst []byte, v ValueDate) ([]byte, error) {
+	return appendDateLiteral(dst, v), nil
+}
+
+func (t typeDate) ScanLiteral(s *LiteralScanner, v *ValueDate) error {
+	return scanDateLiteral(s, v)
+}
+
+func appendDateLiteral(dst []byte, v ValueDate) []byte {
+	dst = append(dst, '\'')
+	dst = appendDateText(dst, v)
+	return append(dst, '\'')
+}
+
+// appendDateText appends v in YYYY-MM-DD form
+func appendDateText(dst []byte, v ValueDate) []byte {
+	return time.Date(int(v.Year), time.Month(v.Month), int(v.Day), 0, 0, 0, 0, time.UTC).AppendFormat(dst, time.DateOnly)
+}
+
+func scanDateLiteral(s *LiteralScanner, v *ValueDate) error {
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	tm, err := time.Parse(time.DateOnly, q)
+	if err != nil {
+		return s.Errorf("%s", err)
+	}
+	*v = ValueDate{Year: uint16(tm.Year()), Month: uint8(tm.Month()), Day: uint8(tm.Day())}
+	return nil
+}
+
+func (t typeDate) AppendJSON(dst []byte, v ValueDate, opts *JSONOptions) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = appendDateText(dst, v)
+	return append(dst, '"'), nil
+}
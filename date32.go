@@ -1,6 +1,7 @@
 package rowbinary
 
 import (
+	"encoding/binary"
 	"time"
 )
 
@@ -25,6 +26,11 @@ func (t typeDate32) Write(w Writer, value ValueDate) error {
 	return Int32.Write(w, days)
 }
 
+func (t typeDate32) Append(dst []byte, v ValueDate) ([]byte, error) {
+	tm := time.Date(int(v.Year), time.Month(v.Month), int(v.Day), 0, 0, 0, 0, time.UTC)
+	return binary.LittleEndian.AppendUint32(dst, uint32(int32(tm.Unix()/secInDay))), nil
+}
+
 func (t typeDate32) Scan(r Reader, v *ValueDate) error {
 	var n int32
 	err := Int32.Scan(r, &n)
@@ -38,3 +44,21 @@ func (t typeDate32) Scan(r Reader, v *ValueDate) error {
 	v.Day = uint8(tm.Day())
 	return nil
 }
+
+func (t typeDate32) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeDate32) AppendLiteral(dst []byte, v ValueDate) ([]byte, error) {
+	return appendDateLiteral(dst, v), nil
+}
+
+func (t typeDate32) ScanLiteral(s *LiteralScanner, v *ValueDate) error {
+	return scanDateLiteral(s, v)
+}
+
+func (t typeDate32) AppendJSON(dst []byte, v ValueDate, opts *JSONOptions) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = appendDateText(dst, v)
+	return append(dst, '"'), nil
+}
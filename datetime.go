@@ -1,6 +1,9 @@
 package rowbinary
 
 import (
+	"encoding/binary"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -23,6 +26,13 @@ func (t typeDateTime) Write(w Writer, value time.Time) error {
 	return UInt32.Write(w, uint32(value.Unix()))
 }
 
+func (t typeDateTime) Append(dst []byte, v time.Time) ([]byte, error) {
+	if v.Year() < 1970 {
+		return binary.LittleEndian.AppendUint32(dst, 0), nil
+	}
+	return binary.LittleEndian.AppendUint32(dst, uint32(v.Unix())), nil
+}
+
 func (t typeDateTime) Scan(r Reader, v *time.Time) error {
 	var n uint32
 	err := UInt32.Scan(r, &n)
@@ -32,3 +42,129 @@ func (t typeDateTime) Scan(r Reader, v *time.Time) error {
 	*v = time.Unix(int64(n), 0).UTC()
 	return nil
 }
+
+func (t typeDateTime) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeDateTime) AppendLiteral(dst []byte, v time.Time) ([]byte, error) {
+	return appendDateTimeUTCLiteral(dst, v, 0), nil
+}
+
+func (t typeDateTime) ScanLiteral(s *LiteralScanner, v *time.Time) error {
+	return scanDateTimeLiteral(s, v, 0, time.UTC)
+}
+
+// appendDateTimeLiteral appends 'YYYY-MM-DD hh:mm:ss[.fraction]' with precision digits of fraction
+func appendDateTimeLiteral(dst []byte, v time.Time, precision int64) []byte {
+	dst = append(dst, '\'')
+	dst = appendDateTimeText(dst, v, precision)
+	return append(dst, '\'')
+}
+
+// appendDateTimeUTCLiteral appends toDateTime('YYYY-MM-DD hh:mm:ss', 'UTC') or toDateTime64 with precision digits
+// of fraction for types without timezone, so the value doesn't depend on timezone of server or column
+func appendDateTimeUTCLiteral(dst []byte, v time.Time, precision int64) []byte {
+	if precision > 0 {
+		dst = append(dst, "toDateTime64("...)
+	} else {
+		dst = append(dst, "toDateTime("...)
+	}
+	dst = appendDateTimeLiteral(dst, v.UTC(), precision)
+	if precision > 0 {
+		dst = fmt.Appendf(dst, ", %d", precision)
+	}
+	return append(dst, ", 'UTC')"...)
+}
+
+// appendDateTimeText appends v in YYYY-MM-DD hh:mm:ss[.fraction] form
+func appendDateTimeText(dst []byte, v time.Time, precision int64) []byte {
+	dst = v.AppendFormat(dst, time.DateTime)
+	if precision > 0 {
+		frac := int64(v.Nanosecond()) / intPow(10, 9-precision)
+		dst = append(dst, '.')
+		dst = fmt.Appendf(dst, "%0*d", precision, frac)
+	}
+	return dst
+}
+
+// scanDateTimeLiteral accepts quoted date time in loc, unquoted Unix timestamp
+// or call of toDateTime('...'[, 'tz']) or toDateTime64('...', precision[, 'tz'])
+func scanDateTimeLiteral(s *LiteralScanner, v *time.Time, precision int64, loc *time.Location) error {
+	call64 := s.ConsumeKeyword("toDateTime64")
+	if call64 || s.ConsumeKeyword("toDateTime") {
+		return scanDateTimeCall(s, v, precision, loc, call64)
+	}
+	if s.Peek() != '\'' {
+		n, err := s.Int(64)
+		if err != nil {
+			return err
+		}
+		*v = time.Unix(n, 0).In(loc)
+		return nil
+	}
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	return parseDateTimeText(s, q, v, precision, loc)
+}
+
+// scanDateTimeCall parses arguments of toDateTime or toDateTime64 call, value is returned in loc
+func scanDateTimeCall(s *LiteralScanner, v *time.Time, precision int64, loc *time.Location, withPrecision bool) error {
+	if err := s.Expect('('); err != nil {
+		return err
+	}
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	if withPrecision {
+		if err := s.Expect(','); err != nil {
+			return err
+		}
+		if _, err := s.Int(8); err != nil {
+			return err
+		}
+	}
+	textLoc := loc
+	if s.Consume(',') {
+		tz, err := s.Quoted()
+		if err != nil {
+			return err
+		}
+		if textLoc, err = time.LoadLocation(tz); err != nil {
+			return s.Errorf("%s", err)
+		}
+	}
+	if err := s.Expect(')'); err != nil {
+		return err
+	}
+	if err := parseDateTimeText(s, q, v, precision, textLoc); err != nil {
+		return err
+	}
+	*v = v.In(loc)
+	return nil
+}
+
+// parseDateTimeText parses q in YYYY-MM-DD[ hh:mm:ss[.fraction]] form in loc
+func parseDateTimeText(s *LiteralScanner, q string, v *time.Time, precision int64, loc *time.Location) error {
+	layout := time.DateTime
+	if strings.Contains(q, ".") {
+		layout = time.DateTime + ".999999999"
+	} else if len(q) == len(time.DateOnly) {
+		layout = time.DateOnly
+	}
+	tm, err := time.ParseInLocation(layout, q, loc)
+	if err != nil {
+		return s.Errorf("%s", err)
+	}
+	*v = tm.Truncate(time.Duration(intPow(10, 9-precision)))
+	return nil
+}
+
+func (t typeDateTime) AppendJSON(dst []byte, v time.Time, opts *JSONOptions) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = appendDateTimeText(dst, v.UTC(), 0)
+	return append(dst, '"'), nil
+}
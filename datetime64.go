@@ -1,6 +1,7 @@
 package rowbinary
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 )
@@ -27,6 +28,10 @@ func (t typeDateTime64) Write(w Writer, value time.Time) error {
 	return Int64.Write(w, value.UnixNano()/intPow(10, 9-t.precision))
 }
 
+func (t typeDateTime64) Append(dst []byte, v time.Time) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, uint64(v.UnixNano()/intPow(10, 9-t.precision))), nil
+}
+
 func intPow(base, exponent int64) int64 {
 	result := int64(1)
 	for i := int64(0); i < exponent; i++ {
@@ -44,3 +49,21 @@ func (t typeDateTime64) Scan(r Reader, v *time.Time) error {
 	*v = time.Unix(0, n*intPow(10, 9-t.precision)).UTC()
 	return nil
 }
+
+func (t typeDateTime64) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeDateTime64) AppendLiteral(dst []byte, v time.Time) ([]byte, error) {
+	return appendDateTimeUTCLiteral(dst, v, t.precision), nil
+}
+
+func (t typeDateTime64) ScanLiteral(s *LiteralScanner, v *time.Time) error {
+	return scanDateTimeLiteral(s, v, t.precision, time.UTC)
+}
+
+func (t typeDateTime64) AppendJSON(dst []byte, v time.Time, opts *JSONOptions) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = appendDateTimeText(dst, v.UTC(), t.precision)
+	return append(dst, '"'), nil
+}
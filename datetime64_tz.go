@@ -1,6 +1,7 @@
 package rowbinary
 
 import (
+	"encoding/binary"
 	"fmt"
 	"slices"
 	"time"
@@ -35,6 +36,13 @@ func (t typeDateTime64TZ) Write(w Writer, value time.Time) error {
 	return Int64.Write(w, value.UnixNano()/intPow(10, 9-t.precision))
 }
 
+func (t typeDateTime64TZ) Append(dst []byte, v time.Time) ([]byte, error) {
+	if t.locErr != nil {
+		return dst, t.locErr
+	}
+	return binary.LittleEndian.AppendUint64(dst, uint64(v.UnixNano()/intPow(10, 9-t.precision))), nil
+}
+
 func (t typeDateTime64TZ) Scan(r Reader, v *time.Time) error {
 	if t.locErr != nil {
 		return t.locErr
@@ -47,3 +55,30 @@ func (t typeDateTime64TZ) Scan(r Reader, v *time.Time) error {
 	*v = time.Unix(0, n*intPow(10, 9-t.precision)).In(t.loc)
 	return nil
 }
+
+func (t typeDateTime64TZ) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeDateTime64TZ) AppendLiteral(dst []byte, v time.Time) ([]byte, error) {
+	if t.locErr != nil {
+		return dst, t.locErr
+	}
+	return appendDateTimeLiteral(dst, v.In(t.loc), t.precision), nil
+}
+
+func (t typeDateTime64TZ) ScanLiteral(s *LiteralScanner, v *time.Time) error {
+	if t.locErr != nil {
+		return t.locErr
+	}
+	return scanDateTimeLiteral(s, v, t.precision, t.loc)
+}
+
+func (t typeDateTime64TZ) AppendJSON(dst []byte, v time.Time, opts *JSONOptions) ([]byte, error) {
+	if t.locErr != nil {
+		return dst, t.locErr
+	}
+	dst = append(dst, '"')
+	dst = appendDateTimeText(dst, v.In(t.loc), t.precision)
+	return append(dst, '"'), nil
+}
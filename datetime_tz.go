@@ -1,6 +1,7 @@
 package rowbinary
 
 import (
+	"encoding/binary"
 	"fmt"
 	"slices"
 	"time"
@@ -37,6 +38,16 @@ func (t typeDateTimeTZ) Write(w Writer, value time.Time) error {
 	return UInt32.Write(w, uint32(value.Unix()))
 }
 
+func (t typeDateTimeTZ) Append(dst []byte, v time.Time) ([]byte, error) {
+	if t.locErr != nil {
+		return dst, t.locErr
+	}
+	if v.Year() < 1970 {
+		return binary.LittleEndian.AppendUint32(dst, 0), nil
+	}
+	return binary.LittleEndian.AppendUint32(dst, uint32(v.Unix())), nil
+}
+
 func (t typeDateTimeTZ) Scan(r Reader, v *time.Time) error {
 	if t.locErr != nil {
 		return t.locErr
@@ -50,3 +61,30 @@ func (t typeDateTimeTZ) Scan(r Reader, v *time.Time) error {
 	*v = time.Unix(int64(n), 0).In(t.loc)
 	return nil
 }
+
+func (t typeDateTimeTZ) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeDateTimeTZ) AppendLiteral(dst []byte, v time.Time) ([]byte, error) {
+	if t.locErr != nil {
+		return dst, t.locErr
+	}
+	return appendDateTimeLiteral(dst, v.In(t.loc), 0), nil
+}
+
+func (t typeDateTimeTZ) ScanLiteral(s *LiteralScanner, v *time.Time) error {
+	if t.locErr != nil {
+		return t.locErr
+	}
+	return scanDateTimeLiteral(s, v, 0, t.loc)
+}
+
+func (t typeDateTimeTZ) AppendJSON(dst []byte, v time.Time, opts *JSONOptions) ([]byte, error) {
+	if t.locErr != nil {
+		return dst, t.locErr
+	}
+	dst = append(dst, '"')
+	dst = appendDateTimeText(dst, v.In(t.loc), 0)
+	return append(dst, '"'), nil
+}
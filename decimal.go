@@ -1,6 +1,8 @@
 package rowbinary
 
 import (
+	"fmt"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -16,3 +18,52 @@ func Decimal(precision uint8, scale uint8) Type[decimal.Decimal] {
 	}
 	return Invalid[decimal.Decimal]("Decimal precision must be in range 1..76")
 }
+
+// appendDecimalLiteral appends toDecimalN('value', scale), so the value is not parsed as Float64 by ClickHouse
+func appendDecimalLiteral(dst []byte, bits int, v decimal.Decimal, scale uint8) ([]byte, error) {
+	if !v.Equal(v.Truncate(int32(scale))) {
+		return dst, fmt.Errorf("value %s has more than %d digits after decimal point", v.String(), scale)
+	}
+	return fmt.Appendf(dst, "toDecimal%d('%s', %d)", bits, v.StringFixed(int32(scale)), scale), nil
+}
+
+// scanDecimalLiteral accepts number, quoted number or call of toDecimalN('value', scale)
+func scanDecimalLiteral(s *LiteralScanner, v *decimal.Decimal, scale uint8) error {
+	var w string
+	var err error
+	switch {
+	case s.ConsumeKeyword("toDecimal32"), s.ConsumeKeyword("toDecimal64"),
+		s.ConsumeKeyword("toDecimal128"), s.ConsumeKeyword("toDecimal256"):
+		if err = s.Expect('('); err != nil {
+			return err
+		}
+		if w, err = s.Quoted(); err != nil {
+			return err
+		}
+		if err = s.Expect(','); err != nil {
+			return err
+		}
+		if _, err = s.Uint(8); err != nil {
+			return err
+		}
+		if err = s.Expect(')'); err != nil {
+			return err
+		}
+	case s.Peek() == '\'':
+		w, err = s.Quoted()
+	default:
+		w, err = s.Word()
+	}
+	if err != nil {
+		return err
+	}
+	d, err := decimal.NewFromString(w)
+	if err != nil {
+		return s.Errorf("%s", err)
+	}
+	if !d.Equal(d.Truncate(int32(scale))) {
+		return s.Errorf("value %s has more than %d digits after decimal point", w, scale)
+	}
+	*v = d.Truncate(int32(scale))
+	return nil
+}
@@ -30,6 +30,26 @@ func (t typeDecimal128) Write(w Writer, value decimal.Decimal) error {
 	return NotImplementedError
 }
 
+func (t typeDecimal128) Append(dst []byte, v decimal.Decimal) ([]byte, error) {
+	return dst, NotImplementedError
+}
+
 func (t typeDecimal128) Scan(r Reader, v *decimal.Decimal) error {
 	return NotImplementedError
 }
+
+func (t typeDecimal128) Skip(r Reader) error {
+	return skipBytes(r, 16)
+}
+
+func (t typeDecimal128) AppendLiteral(dst []byte, v decimal.Decimal) ([]byte, error) {
+	return appendDecimalLiteral(dst, 128, v, t.scale)
+}
+
+func (t typeDecimal128) ScanLiteral(s *LiteralScanner, v *decimal.Decimal) error {
+	return scanDecimalLiteral(s, v, t.scale)
+}
+
+func (t typeDecimal128) AppendJSON(dst []byte, v decimal.Decimal, opts *JSONOptions) ([]byte, error) {
+	return appendJSONDecimal(dst, v.StringFixed(int32(t.scale)), opts), nil
+}
@@ -30,6 +30,26 @@ func (t typeDecimal256) Write(w Writer, value decimal.Decimal) error {
 	return NotImplementedError
 }
 
+func (t typeDecimal256) Append(dst []byte, v decimal.Decimal) ([]byte, error) {
+	return dst, NotImplementedError
+}
+
 func (t typeDecimal256) Scan(r Reader, v *decimal.Decimal) error {
 	return NotImplementedError
 }
+
+func (t typeDecimal256) Skip(r Reader) error {
+	return skipBytes(r, 32)
+}
+
+func (t typeDecimal256) AppendLiteral(dst []byte, v decimal.Decimal) ([]byte, error) {
+	return appendDecimalLiteral(dst, 256, v, t.scale)
+}
+
+func (t typeDecimal256) ScanLiteral(s *LiteralScanner, v *decimal.Decimal) error {
+	return scanDecimalLiteral(s, v, t.scale)
+}
+
+func (t typeDecimal256) AppendJSON(dst []byte, v decimal.Decimal, opts *JSONOptions) ([]byte, error) {
+	return appendJSONDecimal(dst, v.StringFixed(int32(t.scale)), opts), nil
+}
@@ -35,6 +35,11 @@ func (t typeDecimal32) Write(w Writer, value decimal.Decimal) error {
 	return err
 }
 
+func (t typeDecimal32) Append(dst []byte, v decimal.Decimal) ([]byte, error) {
+	part := uint32(decimal.NewFromBigInt(v.Coefficient(), v.Exponent()+int32(t.scale)).IntPart())
+	return binary.LittleEndian.AppendUint32(dst, part), nil
+}
+
 func (t typeDecimal32) Scan(r Reader, v *decimal.Decimal) error {
 	b, err := r.Peek(4)
 	if err != nil {
@@ -47,3 +52,19 @@ func (t typeDecimal32) Scan(r Reader, v *decimal.Decimal) error {
 	*v = decimal.New(int64(n), -int32(t.scale))
 	return nil
 }
+
+func (t typeDecimal32) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeDecimal32) AppendLiteral(dst []byte, v decimal.Decimal) ([]byte, error) {
+	return appendDecimalLiteral(dst, 32, v, t.scale)
+}
+
+func (t typeDecimal32) ScanLiteral(s *LiteralScanner, v *decimal.Decimal) error {
+	return scanDecimalLiteral(s, v, t.scale)
+}
+
+func (t typeDecimal32) AppendJSON(dst []byte, v decimal.Decimal, opts *JSONOptions) ([]byte, error) {
+	return appendJSONDecimal(dst, v.StringFixed(int32(t.scale)), opts), nil
+}
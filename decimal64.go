@@ -34,6 +34,11 @@ func (t typeDecimal64) Write(w Writer, value decimal.Decimal) error {
 	return err
 }
 
+func (t typeDecimal64) Append(dst []byte, v decimal.Decimal) ([]byte, error) {
+	part := uint64(decimal.NewFromBigInt(v.Coefficient(), v.Exponent()+int32(t.scale)).IntPart())
+	return binary.LittleEndian.AppendUint64(dst, part), nil
+}
+
 func (t typeDecimal64) Scan(r Reader, v *decimal.Decimal) error {
 	b, err := r.Peek(8)
 	if err != nil {
@@ -46,3 +51,19 @@ func (t typeDecimal64) Scan(r Reader, v *decimal.Decimal) error {
 	*v = decimal.New(int64(n), -int32(t.scale))
 	return nil
 }
+
+func (t typeDecimal64) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeDecimal64) AppendLiteral(dst []byte, v decimal.Decimal) ([]byte, error) {
+	return appendDecimalLiteral(dst, 64, v, t.scale)
+}
+
+func (t typeDecimal64) ScanLiteral(s *LiteralScanner, v *decimal.Decimal) error {
+	return scanDecimalLiteral(s, v, t.scale)
+}
+
+func (t typeDecimal64) AppendJSON(dst []byte, v decimal.Decimal, opts *JSONOptions) ([]byte, error) {
+	return appendJSONDecimal(dst, v.StringFixed(int32(t.scale)), opts), nil
+}
@@ -0,0 +1,46 @@
+package rowbinary
+
+import (
+	"io"
+	"sync"
+)
+
+var sliceReaderPool = sync.Pool{
+	New: func() any {
+		return new(sliceReader)
+	},
+}
+
+// Decode decodes value of type tp from the beginning of b and returns it with number of bytes consumed,
+// e.g. to read values or rows stored as RowBinary blobs without bufio.Reader.
+// Truncated data gives io.ErrUnexpectedEOF.
+func Decode[T any](tp Type[T], b []byte) (T, int, error) {
+	var v T
+	n, err := decodeSlice(b, func(r Reader) error {
+		return tp.Scan(r, &v)
+	})
+	return v, n, err
+}
+
+// DecodeAny is like Decode for types known at runtime only.
+func DecodeAny(tp Any, b []byte) (any, int, error) {
+	var v any
+	n, err := decodeSlice(b, func(r Reader) error {
+		return tp.ScanAny(r, &v)
+	})
+	return v, n, err
+}
+
+// decodeSlice calls scan with pooled Reader over b
+func decodeSlice(b []byte, scan func(r Reader) error) (int, error) {
+	r := sliceReaderPool.Get().(*sliceReader)
+	r.reset(b)
+	err := scan(r)
+	n := r.off
+	r.reset(nil)
+	sliceReaderPool.Put(r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
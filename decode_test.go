@@ -0,0 +1,73 @@
+package rowbinary
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	for _, v := range testEncodeValues {
+		t.Run(v.Type.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			b, err := AppendAny(nil, v.Type, v.Value)
+			assert.NoError(err)
+			data := append(b, 42)
+
+			x, n, err := DecodeAny(v.Type, data)
+			assert.NoError(err)
+			assert.Equal(len(b), n)
+
+			// compare encoded value, decoded one may have different Go type (e.g. Nullable(Nothing))
+			b2, err := AppendAny(nil, v.Type, x)
+			assert.NoError(err)
+			assert.Equal(b, b2)
+
+			if len(b) > 0 {
+				_, _, err = DecodeAny(v.Type, b[:len(b)-1])
+				assert.Error(err)
+			}
+		})
+	}
+
+	v, n, err := Decode(Array(String), []byte{2, 1, 'a', 0, 7})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []string{"a", ""}, v)
+
+	_, _, err = Decode(UInt32, []byte{1, 2})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestFormatReader_Bytes(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, C("a", UInt64), C("b", Int32))
+	for i := range 100 {
+		assert.NoError(w.WriteAny(uint64(i), int32(-i)))
+	}
+	data := buf.Bytes()
+
+	r := NewFormatReaderBytes(data, C("a", UInt64), C("b", Int32))
+	var a uint64
+	var b int32
+	var sum int64
+	read := func() {
+		r.ResetBytes(data)
+		sum = 0
+		for r.Next() {
+			_ = Scan(r, UInt64, &a)
+			_ = Scan(r, Int32, &b)
+			sum += int64(a) + int64(b)
+		}
+	}
+	allocs := testing.AllocsPerRun(10, read)
+	assert.NoError(r.Err())
+	assert.Equal(int64(0), sum)
+	assert.Equal(int64(100), r.Rows())
+	assert.Equal(float64(0), allocs)
+}
@@ -11,12 +11,14 @@ func Dynamic(maxTypes uint8, knownTypes ...Any) Type[Value] {
 	return MakeTypeWrapAny(typeDynamic{
 		maxTypes:   maxTypes,
 		knownTypes: knownTypes,
+		cache:      newBinaryTypeCache(knownTypes...),
 	})
 }
 
 type typeDynamic struct {
 	maxTypes   uint8
 	knownTypes []Any
+	cache      *binaryTypeCache // decoded value types, shared by copies of typeDynamic
 }
 
 func (t typeDynamic) String() string {
@@ -38,17 +40,66 @@ func (t typeDynamic) Write(w Writer, value Value) error {
 	return value.Type.WriteAny(w, value.Value)
 }
 
+func (t typeDynamic) Append(dst []byte, v Value) ([]byte, error) {
+	if v.Type == nil {
+		return dst, TypeMismatchError{ExpectedType: t.String(), ActualType: "<nil>"}
+	}
+	return appendAny(append(dst, v.Type.Binary()...), v.Type, v.Value)
+}
+
 func (t typeDynamic) Scan(r Reader, v *Value) error {
-	tp, err := DecodeBinaryType(r)
+	tp, err := t.cache.decode(r)
 	if err != nil {
 		return err
 	}
 	v.Type = tp
-	for _, k := range t.knownTypes {
-		if k.ID() == tp.ID() {
-			v.Type = k
-			break
+	return v.Type.ScanAny(r, &v.Value)
+}
+
+func (t typeDynamic) Skip(r Reader) error {
+	tp, err := t.cache.decode(r)
+	if err != nil {
+		return err
+	}
+	return Skip(r, tp)
+}
+
+func (t typeDynamic) AppendLiteral(dst []byte, value Value) ([]byte, error) {
+	if value.Type == nil {
+		return append(dst, "NULL"...), nil
+	}
+	return appendLiteralAny(dst, value.Type, value.Value)
+}
+
+// ScanLiteral parses NULL, literals of known types or infers String, Int64, Float64 and Bool values
+func (t typeDynamic) ScanLiteral(s *LiteralScanner, v *Value) error {
+	if s.ConsumeKeyword("NULL") {
+		*v = Value{}
+		return nil
+	}
+	pos := s.pos
+	for _, tp := range t.knownTypes {
+		x, err := scanLiteralAny(s, tp)
+		if err == nil {
+			*v = Value{Type: tp, Value: x}
+			return nil
 		}
+		s.pos = pos
 	}
-	return v.Type.ScanAny(r, &v.Value)
+	for _, tp := range []Any{String, Int64, Float64, Bool} {
+		x, err := scanLiteralAny(s, tp)
+		if err == nil {
+			*v = Value{Type: tp, Value: x}
+			return nil
+		}
+		s.pos = pos
+	}
+	return s.Errorf("can't infer type of Dynamic value")
+}
+
+func (t typeDynamic) AppendJSON(dst []byte, value Value, opts *JSONOptions) ([]byte, error) {
+	if value.Type == nil {
+		return append(dst, "null"...), nil
+	}
+	return appendJSONAny(dst, value.Type, value.Value, opts)
 }
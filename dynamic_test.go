@@ -0,0 +1,91 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamic_TypeCache(t *testing.T) {
+	assert := assert.New(t)
+
+	known := Array(Int64)
+	tp := Dynamic(0, known)
+
+	values := []Value{
+		{Array(Int64), []int64{42, 43}},
+		{Map(String, UInt8), map[string]uint8{"a": 1}},
+		{Map(String, UInt8), map[string]uint8{"b": 2}},
+		{Enum8(map[string]int8{"a": 1, "b": 2}), "b"},
+		{known, []int64{}},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, v := range values {
+		assert.NoError(tp.Write(w, v))
+	}
+
+	r := NewReader(bytes.NewReader(buf.Bytes()))
+	var scanned []Value
+	for range values {
+		var v Value
+		assert.NoError(tp.Scan(r, &v))
+		scanned = append(scanned, v)
+	}
+
+	assert.Same(known, scanned[0].Type)
+	assert.Same(known, scanned[4].Type)
+	assert.Same(scanned[1].Type, scanned[2].Type)
+	assert.Equal(map[any]any{"b": uint8(2)}, scanned[2].Value)
+	assert.Equal("b", scanned[3].Value)
+}
+
+func TestDynamic_TypeCacheAllocs(t *testing.T) {
+	tp := Dynamic(0)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for range 1000 {
+		assert.NoError(t, tp.Write(w, Value{UInt64, uint64(1)}))
+	}
+	data := buf.Bytes()
+
+	br := bytes.NewReader(data)
+	r := NewReader(br)
+	var v Value
+	assert.NoError(t, tp.Scan(r, &v))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := tp.Scan(r, &v); err != nil {
+			t.Fatal(err)
+		}
+	})
+	// only the scanned value itself, type is taken from cache
+	assert.LessOrEqual(t, allocs, 1.0)
+}
+
+func TestBinaryTypeLen(t *testing.T) {
+	types := []Any{
+		UInt8,
+		DateTimeTZ("Asia/Shanghai"),
+		DateTime64TZ(3, "UTC"),
+		FixedString(10),
+		Enum16(map[string]int16{"a": 1, "b": 1024}),
+		Decimal(18, 4),
+		Map(String, Array(Nullable(UInt32))),
+		TupleNamedAny(C("i", UInt32), C("s", LowCardinality(String))),
+		Variant(String, UInt64),
+		Dynamic(8),
+		IntervalDay,
+		Point,
+	}
+	for _, tp := range types {
+		bin := tp.Binary()
+		r := NewReader(bytes.NewReader(append(bin, 0xff)))
+		n, err := binaryTypeLen(r)
+		assert.NoError(t, err, tp.String())
+		assert.Equal(t, len(bin), n, tp.String())
+	}
+}
@@ -2,6 +2,7 @@ package rowbinary
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"slices"
 	"strings"
@@ -63,6 +64,14 @@ func (t typeEnum16) Write(w Writer, value string) error {
 	return Int16.Write(w, v)
 }
 
+func (t typeEnum16) Append(dst []byte, v string) ([]byte, error) {
+	x, ok := t.mp2[v]
+	if !ok {
+		return dst, fmt.Errorf("invalid enum value %q", v)
+	}
+	return binary.LittleEndian.AppendUint16(dst, uint16(x)), nil
+}
+
 func (t typeEnum16) Scan(r Reader, ret *string) error {
 	var v int16
 	err := Int16.Scan(r, &v)
@@ -77,3 +86,42 @@ func (t typeEnum16) Scan(r Reader, ret *string) error {
 	}
 	return nil
 }
+
+func (t typeEnum16) Skip(r Reader) error {
+	return skipBytes(r, 2)
+}
+
+func (t typeEnum16) AppendLiteral(dst []byte, v string) ([]byte, error) {
+	if _, ok := t.mp2[v]; !ok {
+		return dst, fmt.Errorf("invalid enum value %q", v)
+	}
+	return AppendQuotedLiteral(dst, v), nil
+}
+
+func (t typeEnum16) ScanLiteral(s *LiteralScanner, v *string) error {
+	if s.Peek() != '\'' {
+		n, err := s.Int(16)
+		if err != nil {
+			return err
+		}
+		name, ok := t.mp1[int16(n)]
+		if !ok {
+			return s.Errorf("invalid enum value %d", n)
+		}
+		*v = name
+		return nil
+	}
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	if _, ok := t.mp2[q]; !ok {
+		return s.Errorf("invalid enum value %q", q)
+	}
+	*v = q
+	return nil
+}
+
+func (t typeEnum16) AppendJSON(dst []byte, v string, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, v), nil
+}
@@ -63,6 +63,14 @@ func (t typeEnum8) Write(w Writer, value string) error {
 	return Int8.Write(w, v)
 }
 
+func (t typeEnum8) Append(dst []byte, v string) ([]byte, error) {
+	x, ok := t.mp2[v]
+	if !ok {
+		return dst, fmt.Errorf("invalid enum value %q", v)
+	}
+	return append(dst, byte(x)), nil
+}
+
 func (t typeEnum8) Scan(r Reader, v *string) error {
 	var val int8
 	err := Int8.Scan(r, &val)
@@ -77,3 +85,42 @@ func (t typeEnum8) Scan(r Reader, v *string) error {
 	}
 	return nil
 }
+
+func (t typeEnum8) Skip(r Reader) error {
+	return skipBytes(r, 1)
+}
+
+func (t typeEnum8) AppendLiteral(dst []byte, v string) ([]byte, error) {
+	if _, ok := t.mp2[v]; !ok {
+		return dst, fmt.Errorf("invalid enum value %q", v)
+	}
+	return AppendQuotedLiteral(dst, v), nil
+}
+
+func (t typeEnum8) ScanLiteral(s *LiteralScanner, v *string) error {
+	if s.Peek() != '\'' {
+		n, err := s.Int(8)
+		if err != nil {
+			return err
+		}
+		name, ok := t.mp1[int8(n)]
+		if !ok {
+			return s.Errorf("invalid enum value %d", n)
+		}
+		*v = name
+		return nil
+	}
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	if _, ok := t.mp2[q]; !ok {
+		return s.Errorf("invalid enum value %q", q)
+	}
+	*v = q
+	return nil
+}
+
+func (t typeEnum8) AppendJSON(dst []byte, v string, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, v), nil
+}
@@ -27,3 +27,23 @@ func NewInvalidTypeError(msg string) error {
 func (e TypeMismatchError) Error() string {
 	return fmt.Sprintf("type mismatch: expected %q, got %q", e.ExpectedType, e.ActualType)
 }
+
+// PositionError is returned by FormatReader and FormatWriter when a value can't be decoded or encoded.
+// It describes location of the value in stream and wraps the original error,
+// so errors.Is and errors.As work with it as well as with the original error.
+type PositionError struct {
+	Row    int64  // zero-based index of row
+	Column int    // zero-based index of column
+	Name   string // column name
+	Type   string // column type
+	Offset int64  // byte offset of value from the beginning of stream, -1 if unknown (Native format)
+	Err    error
+}
+
+func (e PositionError) Error() string {
+	return fmt.Sprintf("row %d, column %d (%s %s), offset %d: %v", e.Row, e.Column, e.Name, e.Type, e.Offset, e.Err)
+}
+
+func (e PositionError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,58 @@
+package rowbinary
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionError(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithNamesAndTypes, C("a", UInt32), C("b", String))
+	assert.NoError(w.WriteAny(uint32(1), "x"))
+	assert.NoError(w.WriteAny(uint32(2), "yyy"))
+	header := 1 + 2*2 + 1 + len("UInt32") + 1 + len("String") // count, names, types
+	data := buf.Bytes()[:buf.Len()-1]
+
+	r := NewFormatReader(bytes.NewReader(data), RowBinaryWithNamesAndTypes)
+	var a uint32
+	var b string
+	for r.Next() {
+		if r.Scan(&a, &b) != nil {
+			break
+		}
+	}
+	err := r.Err()
+	assert.ErrorIs(err, io.EOF)
+	var pe PositionError
+	assert.True(errors.As(err, &pe))
+	assert.Equal(PositionError{
+		Row:    1,
+		Column: 1,
+		Name:   "b",
+		Type:   "String",
+		Offset: int64(header + 4 + 2 + 4),
+		Err:    pe.Err,
+	}, pe)
+	assert.Equal("row 1, column 1 (b String), offset 29: EOF", err.Error())
+
+	w = NewFormatWriter(io.Discard, C("a", UInt32), C("e", Enum8(map[string]int8{"a": 1})))
+	assert.NoError(w.WriteAny(uint32(1), "a"))
+	err = w.WriteAny(uint32(2), "b")
+	assert.True(errors.As(err, &pe))
+	assert.Equal(int64(1), pe.Row)
+	assert.Equal("e", pe.Name)
+	assert.Equal(int64(5+4), pe.Offset)
+
+	w = NewFormatWriter(io.Discard, C("a", UInt32))
+	err = w.WriteAny("a")
+	var tm TypeMismatchError
+	assert.True(errors.As(err, &tm))
+	assert.True(errors.As(err, &pe))
+	assert.Equal(int64(0), pe.Row)
+}
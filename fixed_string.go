@@ -33,6 +33,13 @@ func (t typeFixedString) Write(w Writer, value []byte) error {
 	return err
 }
 
+func (t typeFixedString) Append(dst []byte, v []byte) ([]byte, error) {
+	if len(v) != t.length {
+		return dst, fmt.Errorf("invalid length %d, expected %d", len(v), t.length)
+	}
+	return append(dst, v...), nil
+}
+
 func (t typeFixedString) Read(r Reader) ([]byte, error) {
 	buf := make([]byte, t.length)
 	_, err := io.ReadAtLeast(r, buf, t.length)
@@ -66,6 +73,10 @@ func (t typeFixedString) Scan(r Reader, p *[]byte) error {
 	return nil
 }
 
+func (t typeFixedString) Skip(r Reader) error {
+	return skipBytes(r, t.length)
+}
+
 func (t typeFixedString) ScanAny(r Reader, a any) error {
 	p, ok := a.(*[]byte)
 	if !ok {
@@ -74,3 +85,31 @@ func (t typeFixedString) ScanAny(r Reader, a any) error {
 
 	return t.Scan(r, p)
 }
+
+func (t typeFixedString) AppendLiteral(dst []byte, v []byte) ([]byte, error) {
+	if len(v) != t.length {
+		return dst, fmt.Errorf("invalid length %d, expected %d", len(v), t.length)
+	}
+	return AppendQuotedLiteral(dst, string(v)), nil
+}
+
+func (t typeFixedString) ScanLiteral(s *LiteralScanner, v *[]byte) error {
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	if len(q) > t.length {
+		return s.Errorf("too long value for %s", t.String())
+	}
+	// ClickHouse pads short values with zero bytes
+	*v = append((*v)[:0], q...)
+	*v = append(*v, make([]byte, t.length-len(q))...)
+	return nil
+}
+
+func (t typeFixedString) AppendJSON(dst []byte, v []byte, opts *JSONOptions) ([]byte, error) {
+	if len(v) != t.length {
+		return dst, fmt.Errorf("invalid length %d, expected %d", len(v), t.length)
+	}
+	return appendJSONString(dst, string(v)), nil
+}
@@ -21,6 +21,10 @@ func (t typeFloat32) Write(w Writer, value float32) error {
 	return UInt32.Write(w, math.Float32bits(value))
 }
 
+func (t typeFloat32) Append(dst []byte, v float32) ([]byte, error) {
+	return binary.LittleEndian.AppendUint32(dst, math.Float32bits(v)), nil
+}
+
 func (t typeFloat32) Scan(r Reader, v *float32) error {
 	b, err := r.Peek(4)
 	if err != nil {
@@ -32,3 +36,21 @@ func (t typeFloat32) Scan(r Reader, v *float32) error {
 	}
 	return nil
 }
+
+func (t typeFloat32) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeFloat32) AppendLiteral(dst []byte, v float32) ([]byte, error) {
+	return appendFloatLiteral(dst, float64(v), 32), nil
+}
+
+func (t typeFloat32) ScanLiteral(s *LiteralScanner, v *float32) error {
+	f, err := s.Float(32)
+	*v = float32(f)
+	return err
+}
+
+func (t typeFloat32) AppendJSON(dst []byte, v float32, opts *JSONOptions) ([]byte, error) {
+	return appendJSONFloat(dst, float64(v), 32), nil
+}
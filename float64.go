@@ -21,6 +21,10 @@ func (t typeFloat64) Write(w Writer, value float64) error {
 	return UInt64.Write(w, math.Float64bits(value))
 }
 
+func (t typeFloat64) Append(dst []byte, v float64) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, math.Float64bits(v)), nil
+}
+
 func (t typeFloat64) Scan(r Reader, v *float64) error {
 	b, err := r.Peek(8)
 	if err != nil {
@@ -32,3 +36,20 @@ func (t typeFloat64) Scan(r Reader, v *float64) error {
 	}
 	return nil
 }
+
+func (t typeFloat64) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeFloat64) AppendLiteral(dst []byte, v float64) ([]byte, error) {
+	return appendFloatLiteral(dst, v, 64), nil
+}
+
+func (t typeFloat64) ScanLiteral(s *LiteralScanner, v *float64) (err error) {
+	*v, err = s.Float(64)
+	return err
+}
+
+func (t typeFloat64) AppendJSON(dst []byte, v float64, opts *JSONOptions) ([]byte, error) {
+	return appendJSONFloat(dst, v, 64), nil
+}
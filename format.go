@@ -10,6 +10,11 @@ const (
 	RowBinary                  Format = 0
 	RowBinaryWithNames         Format = 1
 	RowBinaryWithNamesAndTypes Format = 2
+	// RowBinaryWithDefaults is input only format. Every value is prefixed with a byte,
+	// 1 means the column DEFAULT expression is used instead of the value
+	RowBinaryWithDefaults Format = 3
+	// Native is columnar format. Rows are transcoded block by block, FormatWriter buffers rows until Flush
+	Native Format = 4
 )
 
 func (f Format) In(other ...Format) bool {
@@ -24,6 +29,10 @@ func (f Format) String() string {
 		return "RowBinaryWithNames"
 	case RowBinaryWithNamesAndTypes:
 		return "RowBinaryWithNamesAndTypes"
+	case RowBinaryWithDefaults:
+		return "RowBinaryWithDefaults"
+	case Native:
+		return "Native"
 	default:
 		return "Unknown"
 	}
@@ -4,12 +4,38 @@ type useBinaryHeaderType struct {
 	value bool
 }
 
+type lenientWriteAnyType struct {
+	value bool
+}
+
+type nilAsDefaultType struct {
+	value bool
+}
+
+type projectionType struct {
+	value []string
+}
+
+type readBufferSizeType struct {
+	value int
+}
+
 var _ FormatOption = WithUseBinaryHeader(false)
+var _ FormatOption = WithLenientWriteAny(false)
+var _ FormatOption = WithNilAsDefault(false)
+var _ FormatOption = WithProjection()
+var _ FormatOption = WithReadBufferSize(0)
 
 type formatOptions struct {
 	format          Format
 	columns         []Column
 	useBinaryHeader bool
+	lenientWriteAny bool
+	nilAsDefault    bool
+	projection      []string
+	schemaMode      SchemaMode
+	readBufferSize  int
+	limits          Limits
 }
 
 type FormatOption interface {
@@ -48,3 +74,85 @@ func (o useBinaryHeaderType) applyClientOptions(opts *clientOptions) {
 	opts.defaultSelect = append(opts.defaultSelect, o)
 	opts.defaultInsert = append(opts.defaultInsert, o)
 }
+
+// WithLenientWriteAny enables coercion of values passed to FormatWriter.WriteAny.
+// Values are converted to the column Go type with Coerce rules instead of strict type assertion.
+func WithLenientWriteAny(value bool) lenientWriteAnyType {
+	return lenientWriteAnyType{
+		value: value,
+	}
+}
+
+func (o lenientWriteAnyType) applyFormatOption(opts *formatOptions) {
+	opts.lenientWriteAny = o.value
+}
+
+func (o lenientWriteAnyType) applyInsertOptions(opts *insertOptions) {
+	opts.formatOptions = append(opts.formatOptions, o)
+}
+
+func (o lenientWriteAnyType) applyExternalDataOption(opts *externalData) {
+	opts.formatOptions = append(opts.formatOptions, o)
+}
+
+func (o lenientWriteAnyType) applyClientOptions(opts *clientOptions) {
+	opts.defaultInsert = append(opts.defaultInsert, o)
+}
+
+// WithNilAsDefault makes FormatWriter write nil values of Nullable columns as defaults in RowBinaryWithDefaults format,
+// so column DEFAULT expression is used instead of NULL.
+func WithNilAsDefault(value bool) nilAsDefaultType {
+	return nilAsDefaultType{
+		value: value,
+	}
+}
+
+func (o nilAsDefaultType) applyFormatOption(opts *formatOptions) {
+	opts.nilAsDefault = o.value
+}
+
+func (o nilAsDefaultType) applyInsertOptions(opts *insertOptions) {
+	opts.formatOptions = append(opts.formatOptions, o)
+}
+
+func (o nilAsDefaultType) applyClientOptions(opts *clientOptions) {
+	opts.defaultInsert = append(opts.defaultInsert, o)
+}
+
+// WithProjection makes FormatReader decode only columns with names, other columns are skipped automatically.
+// Scan reads projected columns in order of result, Columns and ReadRow report projected columns only
+// and ReadBatch expects buffers for projected columns only.
+func WithProjection(names ...string) projectionType {
+	return projectionType{
+		value: names,
+	}
+}
+
+func (o projectionType) applyFormatOption(opts *formatOptions) {
+	opts.projection = o.value
+}
+
+func (o projectionType) applySelectOptions(opts *selectOptions) {
+	opts.formatOptions = append(opts.formatOptions, o)
+}
+
+// WithReadBufferSize sets size of buffer used by FormatReader to read the stream, default is DefaultReadBufferSize.
+// Values larger than the buffer are supported, they are copied in chunks.
+func WithReadBufferSize(size int) readBufferSizeType {
+	return readBufferSizeType{
+		value: size,
+	}
+}
+
+func (o readBufferSizeType) applyFormatOption(opts *formatOptions) {
+	opts.readBufferSize = o.value
+}
+
+func (o readBufferSizeType) applySelectOptions(opts *selectOptions) {
+	opts.formatOptions = append(opts.formatOptions, o)
+	opts.readBufferSize = o.value
+}
+
+func (o readBufferSizeType) applyClientOptions(opts *clientOptions) {
+	opts.defaultSelect = append(opts.defaultSelect, o)
+}
@@ -1,7 +1,7 @@
 package rowbinary
 
 import (
-	"encoding/binary"
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
@@ -14,11 +14,23 @@ type FormatReader struct {
 	index    int
 	firstErr error
 	doneInit bool // read header from remote on first Read or Next
+	native   *nativeReader
+	skipped  []bool   // columns excluded by projection or unknown in SchemaTolerant mode
+	decoded  []Column // columns not skipped
+	missing  []Column // declared columns absent in result in SchemaTolerant mode
+	// decoded and missing columns for ReadRow
+	rowColumns []Column
+	counter    countingReader
+	bytes      sliceReader // source of NewFormatReaderBytes
+	rows       int64       // number of rows read
+	buf        *bufio.Reader
+	borrowBuf  []byte // values larger than read buffer returned by BorrowBytes
+	limit      limitReader
+	abort      func() // stops reading of Client.Select response
 }
 
 func NewFormatReader(wrap io.Reader, opts ...FormatOption) *FormatReader {
 	r := &FormatReader{
-		wrap: NewReader(wrap),
 		options: formatOptions{
 			format:          RowBinary,
 			useBinaryHeader: false,
@@ -29,9 +41,70 @@ func NewFormatReader(wrap io.Reader, opts ...FormatOption) *FormatReader {
 		opt.applyFormatOption(&r.options)
 	}
 
+	r.Reset(wrap)
 	return r
 }
 
+// Reset discards state of reader and makes it read from wrap with the same options.
+// Read buffer allocated by the reader is reused.
+func (r *FormatReader) Reset(wrap io.Reader) {
+	if rr, ok := wrap.(Reader); ok {
+		r.reset(rr)
+		return
+	}
+	if r.buf == nil {
+		size := r.options.readBufferSize
+		if size <= 0 {
+			size = DefaultReadBufferSize
+		}
+		r.buf = bufio.NewReaderSize(wrap, size)
+	} else {
+		r.buf.Reset(wrap)
+	}
+	r.reset(r.buf)
+}
+
+// NewFormatReaderBytes creates FormatReader decoding data from b without buffering and copying.
+// Values referencing b (e.g. scanned with zero-copy) are valid while b is not modified.
+func NewFormatReaderBytes(b []byte, opts ...FormatOption) *FormatReader {
+	r := &FormatReader{
+		options: formatOptions{
+			format:          RowBinary,
+			useBinaryHeader: false,
+		},
+	}
+
+	for _, opt := range opts {
+		opt.applyFormatOption(&r.options)
+	}
+
+	r.ResetBytes(b)
+	return r
+}
+
+// ResetBytes discards state of reader and makes it decode data from b with the same options.
+// Reading RowBinary data with columns set in options doesn't allocate.
+func (r *FormatReader) ResetBytes(b []byte) {
+	r.bytes.reset(b)
+	r.reset(&r.bytes)
+}
+
+// reset discards state of reader and makes it read from wrap
+func (r *FormatReader) reset(wrap Reader) {
+	r.counter = countingReader{Reader: wrap}
+	r.wrap = r.limited(&r.counter)
+	r.columns = nil
+	r.index = 0
+	r.firstErr = nil
+	r.doneInit = false
+	r.native = nil
+	r.skipped = nil
+	r.decoded = nil
+	r.missing = nil
+	r.rowColumns = nil
+	r.rows = 0
+}
+
 func (r *FormatReader) Err() error {
 	return r.firstErr
 }
@@ -52,9 +125,20 @@ func (r *FormatReader) Next() bool {
 		return false
 	}
 
+	// finish row after BorrowBytes
+	if r.index != 0 {
+		if err := r.skipUnprojected(); err != nil {
+			return false
+		}
+	}
+
+	if r.native != nil && !r.nextNativeBlock() {
+		return false
+	}
+
 	_, err := r.wrap.ReadByte()
 	if err != nil && err != io.EOF {
-		r.setErr(err)
+		r.valueErr(0, r.offset(), err)
 	}
 	if err != nil {
 		return false
@@ -67,6 +151,43 @@ func (r *FormatReader) Next() bool {
 
 func (r *FormatReader) nextColumn() {
 	r.index = (r.index + 1) % len(r.columns)
+	if r.index == 0 {
+		r.rows++
+		r.limit.rowBytes = 0
+	}
+}
+
+// limited wraps src with reader enforcing limits of options if they are set
+func (r *FormatReader) limited(src Reader) Reader {
+	if r.options.limits == (Limits{}) {
+		return src
+	}
+	r.limit = limitReader{
+		Reader: src,
+		limits: &r.options.limits,
+		maxRow: r.options.limits.MaxRowBytes,
+	}
+	return &r.limit
+}
+
+// offset returns number of bytes consumed from stream, -1 for Native format where rows are transcoded
+func (r *FormatReader) offset() int64 {
+	if r.native != nil {
+		return -1
+	}
+	return r.counter.n
+}
+
+// valueErr sets error of value of column i in the current row starting at offset
+func (r *FormatReader) valueErr(i int, offset int64, err error) error {
+	return r.setErr(PositionError{
+		Row:    r.rows,
+		Column: i,
+		Name:   r.columns[i].name,
+		Type:   r.columns[i].tp.String(),
+		Offset: offset,
+		Err:    err,
+	})
 }
 
 func (r *FormatReader) check() error {
@@ -78,10 +199,18 @@ func (r *FormatReader) check() error {
 		return nil
 	}
 
+	// header is not a part of the first row
+	r.limit.maxRow = 0
 	err := r.readHeader()
 	if err != nil {
 		return r.setErr(err)
 	}
+	r.limit.maxRow = r.options.limits.MaxRowBytes
+	r.limit.rowBytes = 0
+
+	if err = r.matchColumns(); err != nil {
+		return r.setErr(err)
+	}
 
 	r.doneInit = true
 	return nil
@@ -105,15 +234,15 @@ func (r *FormatReader) readHeaderRowBinaryWithNames() error {
 	}
 
 	// read number of columns
-	n, err := binary.ReadUvarint(r.wrap)
+	n, err := readCollectionLen(r.wrap)
 	if err != nil {
 		return r.setErr(err)
 	}
 
-	remote := make([]Column, 0, n)
+	remote := make([]Column, 0, preallocLen(n))
 
 	// read names and match types from options
-	for i := 0; i < int(n); i++ {
+	for i := 0; i < n; i++ {
 		var name string
 		err = String.Scan(r.wrap, &name)
 		if err != nil {
@@ -133,21 +262,16 @@ func (r *FormatReader) readHeaderRowBinaryWithNames() error {
 // RowBinaryWithNamesAndTypes has header with column names and types
 // If types are set in options, they will be matched against remote types
 func (r *FormatReader) readHeaderRowBinaryWithNamesAndTypes() error {
-	columnTypeMap := make(map[string]Any)
-	for _, col := range r.options.columns {
-		columnTypeMap[col.name] = col.tp
-	}
-
 	// read number of columns
-	n, err := binary.ReadUvarint(r.wrap)
+	n, err := readCollectionLen(r.wrap)
 	if err != nil {
 		return r.setErr(err)
 	}
 
-	remote := make([]Column, 0, n)
+	remote := make([]Column, 0, preallocLen(n))
 
 	// read names
-	for i := 0; i < int(n); i++ {
+	for i := 0; i < n; i++ {
 		var name string
 		err = String.Scan(r.wrap, &name)
 		if err != nil {
@@ -178,8 +302,17 @@ func (r *FormatReader) readHeaderRowBinaryWithNamesAndTypes() error {
 		}
 	}
 
-	// rewrite from options
-	for i := 0; i < int(n); i++ {
+	return r.setColumns(remote)
+}
+
+// setColumns sets remote columns replacing types with ones from options
+func (r *FormatReader) setColumns(remote []Column) error {
+	columnTypeMap := make(map[string]Any)
+	for _, col := range r.options.columns {
+		columnTypeMap[col.name] = col.tp
+	}
+
+	for i := range remote {
 		if tp, ok := columnTypeMap[remote[i].name]; ok {
 			if !Eq(tp, remote[i].tp) {
 				return r.setErr(fmt.Errorf("mismatched column type for column %s. expected %s, got %s", remote[i].name, tp.String(), remote[i].tp.String()))
@@ -192,6 +325,54 @@ func (r *FormatReader) readHeaderRowBinaryWithNamesAndTypes() error {
 	return nil
 }
 
+// Native has header with column names and types in every block.
+// Columns are taken from the first block, rows of the current block are read from transcoded RowBinary
+func (r *FormatReader) readHeaderNative() error {
+	var src Reader = &r.counter
+	if r.options.limits != (Limits{}) {
+		// blocks are checked against value limits, MaxRowBytes applies to transcoded rows
+		src = &limitReader{Reader: src, limits: &r.options.limits}
+	}
+	r.native = newNativeReader(src)
+	r.wrap = r.limited(&r.native.rows)
+	remote, err := r.native.readBlock()
+	if err == io.EOF {
+		// empty result without blocks
+		r.columns = r.options.columns
+		return nil
+	}
+	if err != nil {
+		return r.setErr(err)
+	}
+	return r.setColumns(remote)
+}
+
+// nextNativeBlock reads blocks until one with rows, returns false on the end of data
+func (r *FormatReader) nextNativeBlock() bool {
+	for r.native.rows.Len() == 0 {
+		block, err := r.native.readBlock()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			r.setErr(err)
+			return false
+		}
+		if len(block) != len(r.columns) {
+			r.setErr(fmt.Errorf("got %d columns in Native block, expected %d", len(block), len(r.columns)))
+			return false
+		}
+		for i := range block {
+			if block[i].name != r.columns[i].name || !Eq(block[i].tp, r.columns[i].tp) {
+				r.setErr(fmt.Errorf("unexpected column %s %s in Native block, expected %s %s",
+					block[i].name, block[i].tp.String(), r.columns[i].name, r.columns[i].tp.String()))
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (r *FormatReader) readHeader() error {
 	if r.options.format == RowBinary {
 		return r.readHeaderRowBinary()
@@ -202,6 +383,12 @@ func (r *FormatReader) readHeader() error {
 	if r.options.format == RowBinaryWithNamesAndTypes {
 		return r.readHeaderRowBinaryWithNamesAndTypes()
 	}
+	if r.options.format == Native {
+		return r.readHeaderNative()
+	}
+	if r.options.format == RowBinaryWithDefaults {
+		return fmt.Errorf("format %s is supported for insert only", r.options.format)
+	}
 
 	return fmt.Errorf("unknown format: %v", r.options.format)
 }
@@ -211,17 +398,36 @@ func (r *FormatReader) Columns() (*Columns, error) {
 		return nil, err
 	}
 
-	return &Columns{cols: r.columns}, nil
+	return &Columns{cols: r.decoded}, nil
 }
 
 func (r *FormatReader) Column(i int) (Column, error) {
 	if err := r.check(); err != nil {
 		return Column{}, err
 	}
-	if i < 0 || i >= len(r.columns) {
+	if i < 0 || i >= len(r.decoded) {
 		return Column{}, r.setErr(fmt.Errorf("column index out of bounds: %d", i))
 	}
-	return r.columns[i], nil
+	return r.decoded[i], nil
+}
+
+// Rows returns number of complete rows read or skipped.
+func (r *FormatReader) Rows() int64 {
+	return r.rows
+}
+
+// Bytes returns number of bytes consumed from the underlying reader including header.
+// Native format reads data block by block.
+func (r *FormatReader) Bytes() int64 {
+	return r.counter.n
+}
+
+// MissingColumns returns declared columns absent in result. Only SchemaTolerant mode allows them.
+func (r *FormatReader) MissingColumns() ([]Column, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
+	return r.missing, nil
 }
 
 func (r *FormatReader) Scan(dest ...any) error {
@@ -230,11 +436,18 @@ func (r *FormatReader) Scan(dest ...any) error {
 		return err
 	}
 	for i := 0; i < len(dest); i++ {
+		if err = r.skipUnprojected(); err != nil {
+			return err
+		}
+		off := r.offset()
 		err = r.columns[r.index].tp.ScanAny(r.wrap, dest[i])
 		if err != nil {
-			return r.setErr(err)
+			return r.valueErr(r.index, off, err)
 		}
 		r.nextColumn()
+		if err = r.skipTrailing(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -243,9 +456,12 @@ func Scan[V any](r *FormatReader, tp Type[V], v *V) error {
 	if err := r.check(); err != nil {
 		return err
 	}
+	if err := r.skipUnprojected(); err != nil {
+		return err
+	}
 
 	if tp.ID() != r.columns[r.index].tp.ID() {
-		return r.setErr(fmt.Errorf(
+		return r.valueErr(r.index, r.offset(), fmt.Errorf(
 			"type mismatch. expected %#v (id=%d, binary=%#v), got %#v (id=%d, binary=%#v)",
 			r.columns[r.index].tp.String(),
 			r.columns[r.index].tp.ID(),
@@ -256,7 +472,73 @@ func Scan[V any](r *FormatReader, tp Type[V], v *V) error {
 		))
 	}
 
-	err := tp.Scan(r.wrap, v)
+	off := r.offset()
+	if err := tp.Scan(r.wrap, v); err != nil {
+		return r.valueErr(r.index, off, err)
+	}
 	r.nextColumn()
-	return r.setErr(err)
+	return r.skipTrailing()
+}
+
+// SkipColumn skips value of the current column without decoding it.
+func (r *FormatReader) SkipColumn() error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	if err := r.skipUnprojected(); err != nil {
+		return err
+	}
+	if err := r.skipColumn(); err != nil {
+		return err
+	}
+	r.nextColumn()
+	return r.skipTrailing()
+}
+
+// skipColumn skips value of the current column without moving to the next one
+func (r *FormatReader) skipColumn() error {
+	off := r.offset()
+	if err := Skip(r.wrap, r.columns[r.index].tp); err != nil {
+		return r.valueErr(r.index, off, err)
+	}
+	return nil
+}
+
+// SkipRow skips remaining values of the current row, the whole row if none of its values is read yet.
+func (r *FormatReader) SkipRow() error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	for {
+		if err := r.skipColumn(); err != nil {
+			return err
+		}
+		r.nextColumn()
+		if r.index == 0 {
+			return nil
+		}
+	}
+}
+
+// skipTrailing skips columns excluded by projection after the value just read up to the end of row.
+// Leading columns of the next row are not touched, they are skipped when the row is read after Next
+func (r *FormatReader) skipTrailing() error {
+	if r.index == 0 {
+		return nil
+	}
+	return r.skipUnprojected()
+}
+
+// skipUnprojected skips columns excluded by projection up to the next projected column or the end of row
+func (r *FormatReader) skipUnprojected() error {
+	for r.skipped != nil && r.skipped[r.index] {
+		if err := r.skipColumn(); err != nil {
+			return err
+		}
+		r.nextColumn()
+		if r.index == 0 {
+			return nil
+		}
+	}
+	return nil
 }
@@ -12,11 +12,15 @@ type FormatWriter struct {
 	index    int
 	firstErr error
 	doneInit bool
+	native   *nativeWriter
+	counter  countingWriter
+	out      writer
+	rows     int64  // number of rows written
+	buf      []byte // buffer for values encoded with Append
 }
 
 func NewFormatWriter(wrap io.Writer, opts ...FormatOption) *FormatWriter {
 	w := &FormatWriter{
-		wrap: NewWriter(wrap),
 		options: formatOptions{
 			format:          RowBinary,
 			useBinaryHeader: false,
@@ -27,9 +31,27 @@ func NewFormatWriter(wrap io.Writer, opts ...FormatOption) *FormatWriter {
 		opt.applyFormatOption(&w.options)
 	}
 
+	w.Reset(wrap)
 	return w
 }
 
+// Reset discards state of writer and makes it write to wrap with the same options.
+// Data not flushed to the previous destination is dropped, call Finish before Reset.
+func (w *FormatWriter) Reset(wrap io.Writer) {
+	if ww, ok := wrap.(Writer); ok {
+		w.counter = countingWriter{Writer: ww}
+	} else {
+		w.out.byteWriter = newByteWriter(wrap)
+		w.counter = countingWriter{Writer: &w.out}
+	}
+	w.wrap = &w.counter
+	w.index = 0
+	w.firstErr = nil
+	w.doneInit = false
+	w.native = nil
+	w.rows = 0
+}
+
 func (w *FormatWriter) Err() error {
 	return w.firstErr
 }
@@ -39,7 +61,33 @@ func (w *FormatWriter) Format() Format {
 }
 
 func (w *FormatWriter) nextColumn() {
+	if w.native != nil {
+		w.native.next()
+	}
 	w.index = (w.index + 1) % (len(w.options.columns))
+	if w.index == 0 {
+		w.rows++
+	}
+}
+
+// offset returns number of bytes written to stream, -1 for Native format where rows are buffered
+func (w *FormatWriter) offset() int64 {
+	if w.native != nil {
+		return -1
+	}
+	return w.counter.n
+}
+
+// valueErr sets error of value of column i in the current row starting at offset
+func (w *FormatWriter) valueErr(i int, offset int64, err error) error {
+	return w.setErr(PositionError{
+		Row:    w.rows,
+		Column: i,
+		Name:   w.options.columns[i].name,
+		Type:   w.options.columns[i].tp.String(),
+		Offset: offset,
+		Err:    err,
+	})
 }
 
 func (w *FormatWriter) setErr(err error) error {
@@ -55,6 +103,9 @@ func (w *FormatWriter) check() error {
 	}
 
 	if w.doneInit {
+		if w.native != nil && w.native.rows >= nativeBlockSize && w.native.current == 0 {
+			return w.setErr(w.native.flush())
+		}
 		return nil
 	}
 
@@ -62,6 +113,15 @@ func (w *FormatWriter) check() error {
 		return w.setErr(fmt.Errorf("no columns defined in options"))
 	}
 
+	if w.options.format == Native {
+		native, err := newNativeWriter(w.wrap, w.options.columns)
+		if err != nil {
+			return w.setErr(err)
+		}
+		w.native = native
+		w.wrap = native
+	}
+
 	err := w.writeHeader()
 	if err != nil {
 		return w.setErr(err)
@@ -92,7 +152,7 @@ func (w *FormatWriter) writeHeader() error {
 	if w.firstErr != nil {
 		return w.firstErr
 	}
-	if w.options.format == RowBinary {
+	if w.options.format == RowBinary || w.options.format == RowBinaryWithDefaults || w.options.format == Native {
 		return nil
 	}
 	if w.options.format == RowBinaryWithNames || w.options.format == RowBinaryWithNamesAndTypes {
@@ -128,8 +188,25 @@ func (w *FormatWriter) WriteAny(values ...any) error {
 	}
 
 	for i := range values {
-		if err := w.options.columns[w.index].tp.WriteAny(w.wrap, values[i]); err != nil {
-			return w.setErr(err)
+		tp := w.options.columns[w.index].tp
+		if w.useDefault(tp, values[i]) {
+			if err := w.writeDefault(); err != nil {
+				return err
+			}
+			continue
+		}
+		off := w.offset()
+		if err := w.writeValuePrefix(); err != nil {
+			return err
+		}
+		var err error
+		if w.options.lenientWriteAny {
+			err = WriteAnyLenient(w.wrap, tp, values[i])
+		} else {
+			err = w.appendDone(appendAny(w.appendBuf(), tp, values[i]))
+		}
+		if err != nil {
+			return w.valueErr(w.index, off, err)
 		}
 		w.nextColumn()
 	}
@@ -142,10 +219,138 @@ func Write[V any](w *FormatWriter, tp Type[V], value V) error {
 	}
 
 	if tp.ID() != w.options.columns[w.index].tp.ID() {
-		return w.setErr(fmt.Errorf("type mismatch. expected %s, got %s", w.options.columns[w.index].tp.String(), tp.String()))
+		return w.valueErr(w.index, w.offset(), fmt.Errorf("type mismatch. expected %s, got %s", w.options.columns[w.index].tp.String(), tp.String()))
 	}
 
-	err := tp.Write(w.wrap, value)
+	if w.useDefault(tp, value) {
+		return w.writeDefault()
+	}
+	off := w.offset()
+	if err := w.writeValuePrefix(); err != nil {
+		return err
+	}
+
+	if err := w.appendDone(Append(w.appendBuf(), tp, value)); err != nil {
+		return w.valueErr(w.index, off, err)
+	}
 	w.nextColumn()
-	return w.setErr(err)
+	return nil
+}
+
+// appendBufMax is the capacity of encoding buffer retained between values
+const appendBufMax = 1024 * 1024
+
+// appendBuf returns buffer to append encoded value to.
+// Native format appends directly to values of the current column
+func (w *FormatWriter) appendBuf() []byte {
+	if w.native != nil {
+		return w.native.values[w.native.current].buf
+	}
+	return w.buf[:0]
+}
+
+// appendDone writes value appended to buffer returned by appendBuf
+func (w *FormatWriter) appendDone(b []byte, err error) error {
+	if err != nil {
+		return err
+	}
+	if w.native != nil {
+		w.native.values[w.native.current].buf = b
+		return nil
+	}
+	if cap(b) <= appendBufMax {
+		w.buf = b
+	}
+	_, err = w.wrap.Write(b)
+	return err
+}
+
+// Flush writes rows buffered by Native format as a block. It is a no-op for other formats.
+func (w *FormatWriter) Flush() error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if w.native == nil {
+		return nil
+	}
+	return w.setErr(w.native.flush())
+}
+
+// Finish checks that the last row is complete and writes rows buffered by Native format.
+// Insert calls Finish after WithFormatWriter callback returns, so partial row is reported
+// instead of sending truncated data to server.
+func (w *FormatWriter) Finish() error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if w.index != 0 {
+		return w.setErr(fmt.Errorf("incomplete row %d: %d of %d columns written", w.rows, w.index, len(w.options.columns)))
+	}
+	return w.Flush()
+}
+
+// WriteRow writes values of all columns of a row.
+// Unlike WriteAny it fails if number of values doesn't match number of columns or previous row is incomplete.
+func (w *FormatWriter) WriteRow(values ...any) error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if w.index != 0 {
+		return w.setErr(fmt.Errorf("incomplete row %d: %d of %d columns written", w.rows, w.index, len(w.options.columns)))
+	}
+	if len(values) != len(w.options.columns) {
+		return w.setErr(fmt.Errorf("got %d values, expected %d", len(values), len(w.options.columns)))
+	}
+	return w.WriteAny(values...)
+}
+
+// Rows returns number of complete rows written.
+func (w *FormatWriter) Rows() int64 {
+	return w.rows
+}
+
+// Bytes returns number of bytes written to the underlying writer including header.
+// Native format writes rows on Flush only.
+func (w *FormatWriter) Bytes() int64 {
+	return w.counter.n
+}
+
+// WriteDefault skips value of the current column, so ClickHouse uses the column DEFAULT expression.
+// Supported by RowBinaryWithDefaults format only.
+func (w *FormatWriter) WriteDefault() error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if w.options.format != RowBinaryWithDefaults {
+		return w.setErr(fmt.Errorf("default values are not supported by %s format", w.options.format))
+	}
+	return w.writeDefault()
+}
+
+func (w *FormatWriter) writeDefault() error {
+	off := w.offset()
+	if err := w.wrap.WriteByte(1); err != nil {
+		return w.valueErr(w.index, off, err)
+	}
+	w.nextColumn()
+	return nil
+}
+
+// writeValuePrefix marks following value as explicit in RowBinaryWithDefaults format
+func (w *FormatWriter) writeValuePrefix() error {
+	if w.options.format != RowBinaryWithDefaults {
+		return nil
+	}
+	off := w.offset()
+	if err := w.wrap.WriteByte(0); err != nil {
+		return w.valueErr(w.index, off, err)
+	}
+	return nil
+}
+
+func (w *FormatWriter) useDefault(tp Any, value any) bool {
+	return w.options.nilAsDefault &&
+		w.options.format == RowBinaryWithDefaults &&
+		isNullable(tp) &&
+		coerceIsNil(value)
 }
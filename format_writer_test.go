@@ -0,0 +1,66 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatWriter_WithDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithDefaults, WithNilAsDefault(true), C("a", UInt8), C("b", Nullable(UInt8)))
+	assert.NoError(w.WriteAny(uint8(1), nil))
+	assert.NoError(w.WriteDefault())
+	assert.NoError(Write(w, Nullable(UInt8), pointer(uint8(2))))
+	assert.Equal([]byte{0, 1, 1, 1, 0, 0, 2}, buf.Bytes())
+
+	buf.Reset()
+	w = NewFormatWriter(&buf, RowBinaryWithDefaults, WithNilAsDefault(true), C("a", LowCardinality(Nullable(String))), C("b", LowCardinality(String)))
+	assert.NoError(w.WriteAny(nil, "x"))
+	assert.NoError(w.WriteAny(pointer("y"), "z"))
+	assert.Equal([]byte{1, 0, 1, 'x', 0, 0, 1, 'y', 0, 1, 'z'}, buf.Bytes())
+
+	buf.Reset()
+	w = NewFormatWriter(&buf, RowBinaryWithDefaults, C("a", Nullable(UInt8)))
+	assert.NoError(w.WriteAny((*uint8)(nil)))
+	assert.Equal([]byte{0, 1}, buf.Bytes())
+
+	w = NewFormatWriter(&buf, RowBinary, C("a", UInt8))
+	assert.ErrorContains(w.WriteDefault(), "not supported by RowBinary")
+}
+
+func TestFormatWriter_WriteRow(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithNames, C("a", UInt8), C("b", String))
+	assert.NoError(w.WriteRow(uint8(1), "x"))
+	assert.NoError(w.WriteRow(uint8(2), "yz"))
+	assert.NoError(w.Finish())
+	assert.Equal(int64(2), w.Rows())
+	assert.Equal(int64(buf.Len()), w.Bytes())
+
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNames, C("a", UInt8), C("b", String))
+	assert.True(r.Next())
+	assert.NoError(r.SkipRow())
+	assert.Equal(int64(1), r.Rows())
+	assert.True(r.Next())
+	assert.NoError(r.SkipRow())
+	assert.False(r.Next())
+	assert.Equal(int64(2), r.Rows())
+	assert.Equal(int64(buf.Len()), r.Bytes())
+
+	w = NewFormatWriter(&buf, C("a", UInt8), C("b", String))
+	assert.ErrorContains(w.WriteRow(uint8(1)), "got 1 values, expected 2")
+
+	w = NewFormatWriter(&buf, C("a", UInt8), C("b", String))
+	assert.NoError(w.WriteAny(uint8(1)))
+	assert.ErrorContains(w.WriteRow(uint8(1), "x"), "incomplete row 0: 1 of 2 columns written")
+
+	w = NewFormatWriter(&buf, C("a", UInt8), C("b", String))
+	assert.NoError(w.WriteAny(uint8(1), "x", uint8(2)))
+	assert.ErrorContains(w.Finish(), "incomplete row 1: 1 of 2 columns written")
+}
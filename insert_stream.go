@@ -0,0 +1,208 @@
+package rowbinary
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// insertStreamChunkSize is the size of rows buffered by InsertProducer before they are sent to the request
+const insertStreamChunkSize = 256 * 1024
+
+// insertStreamQueueSize is the number of chunks waiting to be written to the request, producers are blocked when it is full
+const insertStreamQueueSize = 16
+
+// ErrInsertStreamClosed is returned by InsertProducer when rows are sent after InsertStream.Close
+var ErrInsertStreamClosed = errors.New("insert stream is closed")
+
+type insertChunk struct {
+	b    []byte
+	rows int
+}
+
+// InsertStream is an INSERT request receiving rows from concurrent producers, see Client.InsertStream.
+type InsertStream struct {
+	ctx        context.Context
+	opts       []FormatOption // options of producers
+	format     Format         // format of producer rows
+	chunks     chan insertChunk
+	done       chan struct{} // closed when request is finished
+	err        error
+	mu         sync.RWMutex
+	closed     bool
+	bufferPool sync.Pool
+}
+
+func (c *client) InsertStream(ctx context.Context, table string, options ...InsertOption) *InsertStream {
+	opts := insertOptions{
+		params:  map[string]string{},
+		headers: map[string]string{},
+	}
+	for _, opt := range c.opts.defaultInsert {
+		opt.applyInsertOptions(&opts)
+	}
+	for _, opt := range options {
+		opt.applyInsertOptions(&opts)
+	}
+
+	// rows are merged at row boundaries, so producers write plain RowBinary rows. RowBinaryWithDefaults rows
+	// are written as is, so WithNilAsDefault applies to producer rows
+	format := formatOptions{format: RowBinary}
+	for _, opt := range opts.formatOptions {
+		opt.applyFormatOption(&format)
+	}
+	if format.format != RowBinaryWithDefaults {
+		format.format = RowBinary
+	}
+
+	s := &InsertStream{
+		ctx:    ctx,
+		opts:   append(opts.formatOptions, format.format),
+		format: format.format,
+		chunks: make(chan insertChunk, insertStreamQueueSize),
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		s.err = c.Insert(ctx, table, append(options, WithFormatWriter(s.consume))...)
+		close(s.done)
+	}()
+	return s
+}
+
+// consume writes chunks of producers to the request until stream is closed
+func (s *InsertStream) consume(w *FormatWriter) error {
+	for {
+		select {
+		case chunk, ok := <-s.chunks:
+			if !ok {
+				return nil
+			}
+			if err := w.writeRawRows(chunk.b, chunk.rows, s.format); err != nil {
+				return err
+			}
+			s.putBuffer(chunk.b)
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+func (s *InsertStream) getBuffer() []byte {
+	if p, ok := s.bufferPool.Get().(*[]byte); ok {
+		return (*p)[:0]
+	}
+	return make([]byte, 0, insertStreamChunkSize+insertStreamChunkSize/4)
+}
+
+func (s *InsertStream) putBuffer(b []byte) {
+	s.bufferPool.Put(&b)
+}
+
+// send passes chunk to the request, blocks while queue is full
+func (s *InsertStream) send(chunk insertChunk) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrInsertStreamClosed
+	}
+	select {
+	case s.chunks <- chunk:
+		return nil
+	case <-s.done:
+		if s.err != nil {
+			return s.err
+		}
+		return ErrInsertStreamClosed
+	}
+}
+
+// NewProducer creates producer of rows. Producer must be used by one goroutine,
+// create a producer for every goroutine writing rows.
+func (s *InsertStream) NewProducer() *InsertProducer {
+	p := &InsertProducer{stream: s}
+	p.out.b = s.getBuffer()
+	p.w = NewFormatWriter(&p.out, s.opts...)
+	return p
+}
+
+// Close finishes request after rows sent by producers and returns its result.
+// Rows buffered by producers and not flushed are not sent, call InsertProducer.Close before.
+func (s *InsertStream) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.chunks)
+	}
+	s.mu.Unlock()
+	return s.Wait()
+}
+
+// Wait waits for request to finish and returns its result. Request finishes after Close
+// or on error, e.g. when ctx is canceled or server rejects data.
+func (s *InsertStream) Wait() error {
+	<-s.done
+	return s.err
+}
+
+// chunkWriter appends written data to b
+type chunkWriter struct {
+	b []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.b = append(w.b, p...)
+	return len(p), nil
+}
+
+func (w *chunkWriter) WriteByte(c byte) error {
+	w.b = append(w.b, c)
+	return nil
+}
+
+// InsertProducer encodes rows into own buffer and sends complete rows to InsertStream.
+type InsertProducer struct {
+	stream *InsertStream
+	out    chunkWriter
+	w      *FormatWriter
+	sent   int64 // rows of w sent to stream
+}
+
+// Writer returns FormatWriter encoding rows to the producer buffer, e.g. for typed Write.
+// Rows written with it are sent by WriteRow, Flush or Close.
+func (p *InsertProducer) Writer() *FormatWriter {
+	return p.w
+}
+
+// WriteRow encodes row to the producer buffer and sends buffered rows if the buffer is full.
+func (p *InsertProducer) WriteRow(values ...any) error {
+	if err := p.w.WriteRow(values...); err != nil {
+		return err
+	}
+	if len(p.out.b) >= insertStreamChunkSize {
+		return p.Flush()
+	}
+	return nil
+}
+
+// Flush sends buffered rows to the stream. Blocks while queue of stream is full.
+func (p *InsertProducer) Flush() error {
+	if err := p.w.Finish(); err != nil {
+		return err
+	}
+	rows := int(p.w.Rows() - p.sent)
+	if rows == 0 {
+		return nil
+	}
+	if err := p.stream.send(insertChunk{b: p.out.b, rows: rows}); err != nil {
+		return err
+	}
+	p.sent = p.w.Rows()
+	p.out.b = p.stream.getBuffer()
+	return nil
+}
+
+// Close sends buffered rows, producer must not be used after that.
+func (p *InsertProducer) Close() error {
+	return p.Flush()
+}
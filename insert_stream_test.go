@@ -0,0 +1,186 @@
+package rowbinary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testInsertServer decodes inserted rows of columns n UInt64, s String and reports number of rows and sum of n
+func testInsertServer(t *testing.T, result func(rows int, sum uint64)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query().Get("query")
+		var format Format
+		for _, f := range []Format{RowBinary, RowBinaryWithNamesAndTypes, Native} {
+			if strings.HasSuffix(query, " "+f.String()) {
+				format = f
+			}
+		}
+
+		r := NewFormatReader(req.Body, format, C("n", UInt64), C("s", String))
+		rows := 0
+		var sum uint64
+		for r.Next() {
+			var n uint64
+			var s string
+			if err := Scan(r, UInt64, &n); err != nil {
+				break
+			}
+			if err := Scan(r, String, &s); err != nil {
+				break
+			}
+			if s != fmt.Sprint(n) {
+				http.Error(rw, "unexpected value "+s, http.StatusBadRequest)
+				return
+			}
+			rows++
+			sum += n
+		}
+		if err := r.Err(); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result(rows, sum)
+	}))
+}
+
+func TestInsertStream(t *testing.T) {
+	const producers = 8
+	const rowsPerProducer = 20000
+
+	var rows int
+	var sum uint64
+	srv := testInsertServer(t, func(r int, s uint64) {
+		rows, sum = r, s
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(ctx, WithDSN(srv.URL))
+
+	for _, format := range []Format{RowBinary, RowBinaryWithNamesAndTypes, Native} {
+		t.Run(format.String(), func(t *testing.T) {
+			assert := assert.New(t)
+			rows, sum = 0, 0
+
+			s := c.InsertStream(ctx, "t", format, C("n", UInt64), C("s", String))
+			var wg sync.WaitGroup
+			for i := range producers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					p := s.NewProducer()
+					for j := range rowsPerProducer {
+						n := uint64(i*rowsPerProducer + j)
+						var err error
+						if j%2 == 0 {
+							err = p.WriteRow(n, fmt.Sprint(n))
+						} else {
+							// typed writes are sent at the next row boundary
+							err = errors.Join(Write(p.Writer(), UInt64, n), Write(p.Writer(), String, fmt.Sprint(n)))
+						}
+						if err != nil {
+							t.Error(err)
+							return
+						}
+					}
+					assert.NoError(p.Close())
+				}()
+			}
+			wg.Wait()
+
+			assert.NoError(s.Close())
+			assert.Equal(producers*rowsPerProducer, rows)
+			total := uint64(producers * rowsPerProducer)
+			assert.Equal(total*(total-1)/2, sum)
+
+			p := s.NewProducer()
+			assert.NoError(p.WriteRow(uint64(1), "1"))
+			assert.ErrorIs(p.Flush(), ErrInsertStreamClosed)
+		})
+	}
+}
+
+func TestInsertStream_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	// server rejects data
+	srv := testInsertServer(t, func(int, uint64) {})
+	defer srv.Close()
+	c := NewClient(ctx, WithDSN(srv.URL))
+
+	s := c.InsertStream(ctx, "t", RowBinary, C("n", UInt64), C("s", String))
+	p := s.NewProducer()
+	assert.NoError(t, p.WriteRow(uint64(1), "2"))
+	assert.NoError(t, p.Close())
+	assert.ErrorContains(t, s.Close(), "unexpected value 2")
+	assert.Error(t, s.Wait())
+
+	// partial row
+	s = c.InsertStream(ctx, "t", RowBinary, C("n", UInt64), C("s", String))
+	p = s.NewProducer()
+	assert.NoError(t, Write(p.Writer(), UInt64, uint64(1)))
+	assert.ErrorContains(t, p.Flush(), "incomplete row")
+	assert.NoError(t, s.Close())
+
+	// canceled request unblocks producers
+	cctx, cancel := context.WithCancel(ctx)
+	block := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-block
+	}))
+	defer slow.Close()
+	defer close(block)
+
+	c = NewClient(ctx, WithDSN(slow.URL))
+	s = c.InsertStream(cctx, "t", RowBinary, C("n", UInt64), C("s", String))
+	done := make(chan error, 1)
+	go func() {
+		p := s.NewProducer()
+		for i := 0; ; i++ {
+			if err := p.WriteRow(uint64(i), strings.Repeat("x", 1000)); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+	cancel()
+	assert.Error(t, <-done)
+	assert.ErrorIs(t, s.Close(), context.Canceled)
+}
+
+func TestInsertStream_NilAsDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	options := []FormatOption{RowBinaryWithDefaults, WithNilAsDefault(true), C("n", UInt64), C("s", Nullable(String))}
+
+	var expected bytes.Buffer
+	w := NewFormatWriter(&expected, options...)
+	assert.NoError(w.WriteRow(uint64(1), nil))
+	assert.NoError(w.WriteRow(uint64(2), pointer("x")))
+	assert.NoError(w.Finish())
+
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ = io.ReadAll(req.Body)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	s := NewClient(ctx, WithDSN(srv.URL)).InsertStream(ctx, "t", RowBinaryWithDefaults, WithNilAsDefault(true), C("n", UInt64), C("s", Nullable(String)))
+	p := s.NewProducer()
+	assert.NoError(p.WriteRow(uint64(1), nil))
+	assert.NoError(p.WriteRow(uint64(2), pointer("x")))
+	assert.NoError(p.Close())
+	assert.NoError(s.Close())
+	assert.Equal(expected.Bytes(), body)
+}
@@ -1,6 +1,9 @@
 package rowbinary
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"strconv"
+)
 
 var Int16 Type[int16] = MakeTypeWrapAny[int16](typeInt16{})
 
@@ -18,6 +21,10 @@ func (t typeInt16) Write(w Writer, value int16) error {
 	return UInt16.Write(w, uint16(value))
 }
 
+func (t typeInt16) Append(dst []byte, v int16) ([]byte, error) {
+	return binary.LittleEndian.AppendUint16(dst, uint16(v)), nil
+}
+
 func (t typeInt16) Scan(r Reader, v *int16) error {
 	b, err := r.Peek(2)
 	if err != nil {
@@ -29,3 +36,21 @@ func (t typeInt16) Scan(r Reader, v *int16) error {
 	}
 	return nil
 }
+
+func (t typeInt16) Skip(r Reader) error {
+	return skipBytes(r, 2)
+}
+
+func (t typeInt16) AppendLiteral(dst []byte, v int16) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
+
+func (t typeInt16) ScanLiteral(s *LiteralScanner, v *int16) error {
+	n, err := s.Int(16)
+	*v = int16(n)
+	return err
+}
+
+func (t typeInt16) AppendJSON(dst []byte, v int16, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
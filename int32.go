@@ -1,6 +1,9 @@
 package rowbinary
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"strconv"
+)
 
 var Int32 Type[int32] = MakeTypeWrapAny[int32](typeInt32{})
 
@@ -18,6 +21,10 @@ func (t typeInt32) Write(w Writer, value int32) error {
 	return UInt32.Write(w, uint32(value))
 }
 
+func (t typeInt32) Append(dst []byte, v int32) ([]byte, error) {
+	return binary.LittleEndian.AppendUint32(dst, uint32(v)), nil
+}
+
 func (t typeInt32) Scan(r Reader, v *int32) error {
 	b, err := r.Peek(4)
 	if err != nil {
@@ -29,3 +36,21 @@ func (t typeInt32) Scan(r Reader, v *int32) error {
 	}
 	return nil
 }
+
+func (t typeInt32) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeInt32) AppendLiteral(dst []byte, v int32) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
+
+func (t typeInt32) ScanLiteral(s *LiteralScanner, v *int32) error {
+	n, err := s.Int(32)
+	*v = int32(n)
+	return err
+}
+
+func (t typeInt32) AppendJSON(dst []byte, v int32, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
@@ -1,6 +1,9 @@
 package rowbinary
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"strconv"
+)
 
 var Int64 Type[int64] = MakeTypeWrapAny[int64](typeInt64{})
 
@@ -18,6 +21,10 @@ func (t typeInt64) Write(w Writer, value int64) error {
 	return UInt64.Write(w, uint64(value))
 }
 
+func (t typeInt64) Append(dst []byte, v int64) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, uint64(v)), nil
+}
+
 func (t typeInt64) Scan(r Reader, v *int64) error {
 	b, err := r.Peek(8)
 	if err != nil {
@@ -29,3 +36,21 @@ func (t typeInt64) Scan(r Reader, v *int64) error {
 	}
 	return nil
 }
+
+func (t typeInt64) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeInt64) AppendLiteral(dst []byte, v int64) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
+
+func (t typeInt64) ScanLiteral(s *LiteralScanner, v *int64) error {
+	n, err := s.Int(64)
+	*v = int64(n)
+	return err
+}
+
+func (t typeInt64) AppendJSON(dst []byte, v int64, opts *JSONOptions) ([]byte, error) {
+	return appendJSONInt64(dst, v, opts), nil
+}
@@ -1,5 +1,7 @@
 package rowbinary
 
+import "strconv"
+
 var Int8 Type[int8] = MakeTypeWrapAny[int8](typeInt8{})
 
 type typeInt8 struct{}
@@ -16,8 +18,30 @@ func (t typeInt8) Write(w Writer, value int8) error {
 	return UInt8.Write(w, uint8(value))
 }
 
+func (t typeInt8) Append(dst []byte, v int8) ([]byte, error) {
+	return append(dst, byte(v)), nil
+}
+
 func (t typeInt8) Scan(r Reader, v *int8) (err error) {
 	b, err := r.ReadByte()
 	*v = int8(b)
 	return err
 }
+
+func (t typeInt8) Skip(r Reader) error {
+	return skipBytes(r, 1)
+}
+
+func (t typeInt8) AppendLiteral(dst []byte, v int8) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
+
+func (t typeInt8) ScanLiteral(s *LiteralScanner, v *int8) error {
+	n, err := s.Int(8)
+	*v = int8(n)
+	return err
+}
+
+func (t typeInt8) AppendJSON(dst []byte, v int8, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(v), 10), nil
+}
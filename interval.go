@@ -1,6 +1,10 @@
 package rowbinary
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
 
 // https://clickhouse.com/docs/sql-reference/data-types/special-data-types/interval
 // https://clickhouse.com/docs/sql-reference/data-types/data-types-binary-encoding#interval-kind-binary-encoding
@@ -70,6 +74,10 @@ func (t typeInterval) Write(w Writer, value int64) error {
 	return UInt64.Write(w, uint64(value))
 }
 
+func (t typeInterval) Append(dst []byte, v int64) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, uint64(v)), nil
+}
+
 func (t typeInterval) Scan(r Reader, v *int64) (err error) {
 	b, err := r.Peek(8)
 	if err != nil {
@@ -81,3 +89,33 @@ func (t typeInterval) Scan(r Reader, v *int64) (err error) {
 	}
 	return
 }
+
+func (t typeInterval) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeInterval) AppendLiteral(dst []byte, v int64) ([]byte, error) {
+	dst = append(dst, "INTERVAL "...)
+	dst = strconv.AppendInt(dst, v, 10)
+	dst = append(dst, ' ')
+	return append(dst, strings.ToUpper(strings.TrimPrefix(t.String(), "Interval"))...), nil
+}
+
+func (t typeInterval) ScanLiteral(s *LiteralScanner, v *int64) error {
+	if !s.ConsumeKeyword("INTERVAL") {
+		return s.Errorf("expected INTERVAL")
+	}
+	n, err := s.Int(64)
+	if err != nil {
+		return err
+	}
+	if !s.ConsumeKeyword(strings.TrimPrefix(t.String(), "Interval")) {
+		return s.Errorf("expected %s unit", t.String())
+	}
+	*v = n
+	return nil
+}
+
+func (t typeInterval) AppendJSON(dst []byte, v int64, opts *JSONOptions) ([]byte, error) {
+	return appendJSONInt64(dst, v, opts), nil
+}
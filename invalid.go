@@ -19,6 +19,26 @@ func (t typeInvalid[T]) Write(w Writer, value T) error {
 	return NewInvalidTypeError(t.msg)
 }
 
+func (t typeInvalid[T]) Append(dst []byte, v T) ([]byte, error) {
+	return dst, NewInvalidTypeError(t.msg)
+}
+
 func (t typeInvalid[T]) Scan(r Reader, v *T) error {
 	return NewInvalidTypeError(t.msg)
 }
+
+func (t typeInvalid[T]) Skip(r Reader) error {
+	return NewInvalidTypeError(t.msg)
+}
+
+func (t typeInvalid[T]) AppendLiteral(dst []byte, v T) ([]byte, error) {
+	return dst, NewInvalidTypeError(t.msg)
+}
+
+func (t typeInvalid[T]) ScanLiteral(s *LiteralScanner, v *T) error {
+	return NewInvalidTypeError(t.msg)
+}
+
+func (t typeInvalid[T]) AppendJSON(dst []byte, v T, opts *JSONOptions) ([]byte, error) {
+	return dst, NewInvalidTypeError(t.msg)
+}
@@ -1,6 +1,9 @@
 package rowbinary
 
-import "io"
+import (
+	"io"
+	"net/netip"
+)
 
 var IPv4 Type[[4]byte] = MakeTypeWrapAny[[4]byte](typeIPv4{})
 
@@ -22,6 +25,10 @@ func (t typeIPv4) Write(w Writer, value [4]byte) error {
 	return err
 }
 
+func (t typeIPv4) Append(dst []byte, v [4]byte) ([]byte, error) {
+	return append(dst, v[3], v[2], v[1], v[0]), nil
+}
+
 func (t typeIPv4) Read(r Reader) ([4]byte, error) {
 	var ret [4]byte
 	_, err := io.ReadAtLeast(r, ret[:], 4)
@@ -34,3 +41,28 @@ func (t typeIPv4) Scan(r Reader, v *[4]byte) (err error) {
 	swap32((*v)[:])
 	return
 }
+
+func (t typeIPv4) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeIPv4) AppendLiteral(dst []byte, v [4]byte) ([]byte, error) {
+	return AppendQuotedLiteral(dst, netip.AddrFrom4(v).String()), nil
+}
+
+func (t typeIPv4) ScanLiteral(s *LiteralScanner, v *[4]byte) error {
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(q)
+	if err != nil || !addr.Is4() {
+		return s.Errorf("invalid IPv4 address %q", q)
+	}
+	*v = addr.As4()
+	return nil
+}
+
+func (t typeIPv4) AppendJSON(dst []byte, v [4]byte, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, netip.AddrFrom4(v).String()), nil
+}
@@ -1,6 +1,9 @@
 package rowbinary
 
-import "io"
+import (
+	"io"
+	"net/netip"
+)
 
 var IPv6 Type[[16]byte] = MakeTypeWrapAny[[16]byte](typeIPv6{})
 
@@ -19,6 +22,10 @@ func (t typeIPv6) Write(w Writer, value [16]byte) error {
 	return err
 }
 
+func (t typeIPv6) Append(dst []byte, v [16]byte) ([]byte, error) {
+	return append(dst, v[:]...), nil
+}
+
 func (t typeIPv6) Read(r Reader) ([16]byte, error) {
 	var ret [16]byte
 	_, err := io.ReadAtLeast(r, ret[:], 16)
@@ -29,3 +36,28 @@ func (t typeIPv6) Scan(r Reader, v *[16]byte) (err error) {
 	_, err = io.ReadAtLeast(r, (*v)[:], 16)
 	return
 }
+
+func (t typeIPv6) Skip(r Reader) error {
+	return skipBytes(r, 16)
+}
+
+func (t typeIPv6) AppendLiteral(dst []byte, v [16]byte) ([]byte, error) {
+	return AppendQuotedLiteral(dst, netip.AddrFrom16(v).String()), nil
+}
+
+func (t typeIPv6) ScanLiteral(s *LiteralScanner, v *[16]byte) error {
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(q)
+	if err != nil {
+		return s.Errorf("invalid IPv6 address %q", q)
+	}
+	*v = addr.As16()
+	return nil
+}
+
+func (t typeIPv6) AppendJSON(dst []byte, v [16]byte, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, netip.AddrFrom16(v).String()), nil
+}
@@ -0,0 +1,154 @@
+package rowbinary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// errStopIteration is returned from Select callback when the range loop over Rows is stopped
+var errStopIteration = errors.New("iteration stopped")
+
+// Rows executes query and returns iterator over decoded rows of rowType. Query result is read in
+// RowBinaryWithNamesAndTypes format, rowType must be the type of the single column of result or a tuple
+// of types of all columns (e.g. TupleAny), it is checked against the header before any row is decoded.
+// Options must not set columns or format.
+//
+// The request is executed when the iteration starts. An error stops the iteration, it is yielded with zero value.
+// Break from the range loop cancels the request and closes the response body.
+func Rows[T any](ctx context.Context, c Client, query string, rowType Type[T], options ...SelectOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		err := c.Select(ctx, query, append(options[:len(options):len(options)],
+			RowBinaryWithNamesAndTypes,
+			WithFormatReader(func(r *FormatReader) error {
+				columns, err := r.Columns()
+				if err != nil {
+					return err
+				}
+				single, err := matchRowType(rowType, columns.cols)
+				if err != nil {
+					return err
+				}
+				var buf []byte
+				for r.Next() {
+					var v T
+					if single {
+						err = Scan(r, rowType, &v)
+					} else if buf, err = r.ReadRawRow(buf[:0]); err == nil {
+						v, _, err = Decode(rowType, buf)
+					}
+					if err != nil {
+						return err
+					}
+					if !yield(v, nil) {
+						stopped = true
+						return errStopIteration
+					}
+				}
+				return r.Err()
+			}),
+		)...)
+		if err != nil && !stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// InsertRows executes INSERT query into table with rows of the sequence, each row is encoded as one value of rowType.
+// If columns are set in options rowType must be the type of the single column or a tuple of types of all columns,
+// RowBinary format is used unless options set another one.
+// Without columns rows are sent in RowBinary format and rowType must encode all columns of table in order.
+//
+// Sequence is consumed on a separate goroutine while the request is being sent.
+func InsertRows[T any](ctx context.Context, c Client, table string, rowType Type[T], rows iter.Seq[T], options ...InsertOption) error {
+	var columns []Column
+	for _, opt := range options {
+		if col, ok := opt.(Column); ok {
+			columns = append(columns, col)
+		}
+	}
+	if len(columns) > 0 {
+		if _, err := matchRowType(rowType, columns); err != nil {
+			return err
+		}
+	}
+
+	opts := make([]InsertOption, 0, len(options)+3)
+	opts = append(opts, RowBinary)
+	opts = append(opts, options...)
+	if len(columns) == 0 {
+		opts = append(opts, RowBinary, C("row", rowType))
+	}
+	opts = append(opts, WithFormatWriter(func(w *FormatWriter) error {
+		var buf []byte
+		for v := range rows {
+			var err error
+			if buf, err = Append(buf[:0], rowType, v); err != nil {
+				return err
+			}
+			if err = w.WriteRawRow(buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	return c.Insert(ctx, table, opts...)
+}
+
+// matchRowType checks that rowType encodes row of columns. single is true if rowType is the type of the only column,
+// otherwise rowType is a tuple of types of columns
+func matchRowType(rowType Any, columns []Column) (single bool, err error) {
+	if len(columns) == 1 && bytes.Equal(columns[0].tp.Binary(), rowType.Binary()) {
+		return true, nil
+	}
+	elems, ok := tupleElemTypes(rowType.Binary())
+	if ok && len(elems) == len(columns) {
+		match := true
+		for i, col := range columns {
+			match = match && bytes.Equal(col.tp.Binary(), elems[i])
+		}
+		if match {
+			return false, nil
+		}
+	}
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.String()
+	}
+	return false, fmt.Errorf("row type %s doesn't match columns (%s)", rowType.String(), strings.Join(names, ", "))
+}
+
+// tupleElemTypes returns binary encodings of element types of Tuple type encoding bin
+func tupleElemTypes(bin []byte) ([][]byte, bool) {
+	if len(bin) == 0 || ([1]byte{bin[0]} != BinaryTypeTuple && [1]byte{bin[0]} != BinaryTypeTupleNamed) {
+		return nil, false
+	}
+	named := [1]byte{bin[0]} == BinaryTypeTupleNamed
+	r := newSliceReader(bin[1:])
+	n, err := VarintRead(r)
+	if err != nil {
+		return nil, false
+	}
+	var elems [][]byte
+	for range n {
+		if named {
+			if err := Skip(r, String); err != nil {
+				return nil, false
+			}
+		}
+		l, err := binaryTypeLen(r)
+		if err != nil {
+			return nil, false
+		}
+		elems = append(elems, bin[1+r.off:1+r.off+l])
+		if _, err := r.Discard(l); err != nil {
+			return nil, false
+		}
+	}
+	return elems, true
+}
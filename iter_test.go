@@ -0,0 +1,153 @@
+package rowbinary
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRowsCount returns number of rows of seq and the last error
+func testRowsCount[T any](seq iter.Seq2[T, error]) (int, error) {
+	var n int
+	var lastErr error
+	for _, err := range seq {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		n++
+	}
+	return n, lastErr
+}
+
+func TestRows(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal("RowBinaryWithNamesAndTypes", req.Header.Get("X-ClickHouse-Format"))
+		w := NewFormatWriter(rw, RowBinaryWithNamesAndTypes, C("n", UInt64), C("s", String))
+		for i := range 10 {
+			assert.NoError(w.WriteRow(uint64(i), fmt.Sprint(i)))
+		}
+		assert.NoError(w.Finish())
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(ctx, WithDSN(srv.URL))
+
+	var values [][]any
+	for v, err := range Rows(ctx, c, "SELECT n, s", TupleAny(UInt64, String)) {
+		assert.NoError(err)
+		values = append(values, v)
+	}
+	assert.Len(values, 10)
+	assert.Equal([]any{uint64(9), "9"}, values[9])
+
+	values = nil
+	for v, err := range Rows(ctx, c, "SELECT n, s", TupleNamedAny(C("a", UInt64), C("b", String))) {
+		assert.NoError(err)
+		values = append(values, v)
+	}
+	assert.Len(values, 10)
+
+	// row type doesn't match columns, no rows are yielded
+	n, err := testRowsCount(Rows(ctx, c, "SELECT n, s", UInt64))
+	assert.ErrorContains(err, "row type UInt64 doesn't match columns (n UInt64, s String)")
+	assert.Equal(0, n)
+	for _, rowType := range []Type[[]any]{TupleAny(UInt64), TupleAny(UInt64, UInt64), TupleAny(UInt64, String, String)} {
+		n, err := testRowsCount(Rows(ctx, c, "SELECT n, s", rowType))
+		assert.ErrorContains(err, "doesn't match columns (n UInt64, s String)", rowType.String())
+		assert.Equal(0, n)
+	}
+
+	// request error
+	for _, err := range Rows(ctx, NewClient(ctx, WithDSN("http://127.0.0.1:1")), "SELECT n", UInt64) {
+		assert.Error(err)
+	}
+}
+
+func TestRows_Break(t *testing.T) {
+	assert := assert.New(t)
+
+	stopped := make(chan struct{})
+	// endless response
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		defer close(stopped)
+		w := NewFormatWriter(rw, RowBinaryWithNamesAndTypes, C("n", UInt64))
+		for i := uint64(0); ; i++ {
+			if err := w.WriteRow(i); err != nil {
+				return
+			}
+			if req.Context().Err() != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(ctx, WithDSN(srv.URL))
+
+	var last uint64
+	for v, err := range Rows(ctx, c, "SELECT number FROM system.numbers", UInt64) {
+		assert.NoError(err)
+		last = v
+		if v == 100000 {
+			break
+		}
+	}
+	assert.Equal(uint64(100000), last)
+
+	select {
+	case <-stopped:
+	case <-time.After(10 * time.Second):
+		t.Fatal("request is not canceled")
+	}
+}
+
+func TestInsertRows(t *testing.T) {
+	var rows int
+	var sum uint64
+	srv := testInsertServer(t, func(r int, s uint64) {
+		rows, sum = r, s
+	})
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(ctx, WithDSN(srv.URL))
+
+	seq := func(yield func([]any) bool) {
+		for i := range 1000 {
+			if !yield([]any{uint64(i), fmt.Sprint(i)}) {
+				return
+			}
+		}
+	}
+
+	for _, options := range [][]InsertOption{
+		nil,
+		{RowBinaryWithNamesAndTypes, C("n", UInt64), C("s", String)},
+		{Native, C("n", UInt64), C("s", String)},
+	} {
+		rows, sum = 0, 0
+		assert.NoError(t, InsertRows(ctx, c, "t", TupleAny(UInt64, String), seq, options...))
+		assert.Equal(t, 1000, rows)
+		assert.Equal(t, uint64(999*1000/2), sum)
+	}
+
+	err := InsertRows(ctx, c, "t", TupleAny(UInt64, String), func(yield func([]any) bool) {
+		yield([]any{"bad", "1"})
+	})
+	assert.Error(t, err)
+
+	// row type doesn't match columns
+	err = InsertRows(ctx, c, "t", TupleAny(UInt64, UInt64), seq, C("n", UInt64), C("s", String))
+	assert.ErrorContains(t, err, "row type Tuple(UInt64, UInt64) doesn't match columns (n UInt64, s String)")
+}
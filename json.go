@@ -0,0 +1,210 @@
+package rowbinary
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// JSONOptions controls rendering of values in ClickHouse JSON formats.
+// Field names follow the corresponding output_format_json_* settings.
+type JSONOptions struct {
+	// Quote64BitIntegers renders Int64, UInt64 and Interval values as strings (output_format_json_quote_64bit_integers)
+	Quote64BitIntegers bool
+	// QuoteDecimals renders Decimal values as strings (output_format_json_quote_decimals)
+	QuoteDecimals bool
+	// NamedTuplesAsObjects renders named tuples as objects instead of arrays (output_format_json_named_tuples_as_objects)
+	NamedTuplesAsObjects bool
+}
+
+// DefaultJSONOptions returns options matching ClickHouse defaults.
+func DefaultJSONOptions() *JSONOptions {
+	return &JSONOptions{
+		Quote64BitIntegers:   true,
+		QuoteDecimals:        false,
+		NamedTuplesAsObjects: true,
+	}
+}
+
+// JSONType is implemented by types which can render Go values as ClickHouse JSON.
+// All built-in types implement it, including composite ones.
+type JSONType[T any] interface {
+	AppendJSON(dst []byte, v T, opts *JSONOptions) ([]byte, error)
+}
+
+// jsonAny is implemented by typeWrapper for JSON of nested Any types
+type jsonAny interface {
+	appendJSONAny(dst []byte, v any, opts *JSONOptions) ([]byte, error)
+}
+
+var _ JSONType[uint8] = &typeWrapper[uint8]{}
+
+// AppendJSON appends JSON representation of v to dst.
+// Output matches ClickHouse JSONEachRow format. Nil opts means DefaultJSONOptions.
+func AppendJSON[T any](dst []byte, tp Type[T], v T, opts *JSONOptions) ([]byte, error) {
+	if opts == nil {
+		opts = DefaultJSONOptions()
+	}
+	j, ok := tp.(JSONType[T])
+	if !ok {
+		return dst, fmt.Errorf("JSON is not supported for type %s", tp.String())
+	}
+	return j.AppendJSON(dst, v, opts)
+}
+
+// AppendJSONAny is like AppendJSON for types known at runtime only,
+// e.g. column types from the result header.
+func AppendJSONAny(dst []byte, tp Any, v any, opts *JSONOptions) ([]byte, error) {
+	if opts == nil {
+		opts = DefaultJSONOptions()
+	}
+	return appendJSONAny(dst, tp, v, opts)
+}
+
+// AppendJSONEachRow appends a JSONEachRow line {"column": value, ...} terminated by newline.
+func AppendJSONEachRow(dst []byte, columns []Column, values []any, opts *JSONOptions) ([]byte, error) {
+	if len(columns) != len(values) {
+		return dst, fmt.Errorf("got %d values for %d columns", len(values), len(columns))
+	}
+	if opts == nil {
+		opts = DefaultJSONOptions()
+	}
+	dst = append(dst, '{')
+	for i, col := range columns {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendJSONString(dst, col.Name())
+		dst = append(dst, ':')
+		var err error
+		if dst, err = appendJSONAny(dst, col.Type(), values[i], opts); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, '}', '\n'), nil
+}
+
+func appendJSONAny(dst []byte, tp Any, v any, opts *JSONOptions) ([]byte, error) {
+	j, ok := tp.(jsonAny)
+	if !ok {
+		return dst, fmt.Errorf("JSON is not supported for type %s", tp.String())
+	}
+	return j.appendJSONAny(dst, v, opts)
+}
+
+func (t *typeWrapper[T]) AppendJSON(dst []byte, v T, opts *JSONOptions) ([]byte, error) {
+	j, ok := t.PreType.(JSONType[T])
+	if !ok {
+		return dst, fmt.Errorf("JSON is not supported for type %s", t.tstr)
+	}
+	return j.AppendJSON(dst, v, opts)
+}
+
+func (t *typeWrapper[T]) appendJSONAny(dst []byte, v any, opts *JSONOptions) ([]byte, error) {
+	value, ok := v.(T)
+	if !ok {
+		if v != nil || !literalNilable[T]() {
+			return dst, newTypeMismatchError[T](v)
+		}
+	}
+	return t.AppendJSON(dst, value, opts)
+}
+
+func (t typeWrapperAny[T]) AppendJSON(dst []byte, v T, opts *JSONOptions) ([]byte, error) {
+	j, ok := t.BaseType.(JSONType[T])
+	if !ok {
+		return dst, fmt.Errorf("JSON is not supported for type %s", t.String())
+	}
+	return j.AppendJSON(dst, v, opts)
+}
+
+func (t *customType[T]) AppendJSON(dst []byte, v T, opts *JSONOptions) ([]byte, error) {
+	return AppendJSON(dst, t.Type, v, opts)
+}
+
+const jsonHex = "0123456789abcdef"
+
+// appendJSONString appends s as JSON string with ClickHouse escaping rules (forward slashes are escaped)
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\\', '/':
+			dst = append(dst, '\\', c)
+		case '\n':
+			dst = append(dst, '\\', 'n')
+		case '\r':
+			dst = append(dst, '\\', 'r')
+		case '\t':
+			dst = append(dst, '\\', 't')
+		case '\b':
+			dst = append(dst, '\\', 'b')
+		case '\f':
+			dst = append(dst, '\\', 'f')
+		default:
+			if c < 0x20 {
+				dst = append(dst, '\\', 'u', '0', '0', jsonHex[c>>4], jsonHex[c&0xf])
+			} else {
+				dst = append(dst, c)
+			}
+		}
+	}
+	return append(dst, '"')
+}
+
+func appendJSONInt64(dst []byte, v int64, opts *JSONOptions) []byte {
+	if opts.Quote64BitIntegers {
+		dst = append(dst, '"')
+		dst = strconv.AppendInt(dst, v, 10)
+		return append(dst, '"')
+	}
+	return strconv.AppendInt(dst, v, 10)
+}
+
+func appendJSONUint64(dst []byte, v uint64, opts *JSONOptions) []byte {
+	if opts.Quote64BitIntegers {
+		dst = append(dst, '"')
+		dst = strconv.AppendUint(dst, v, 10)
+		return append(dst, '"')
+	}
+	return strconv.AppendUint(dst, v, 10)
+}
+
+// appendJSONFloat renders nan and inf as null
+func appendJSONFloat(dst []byte, f float64, bitSize int) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendFloat(dst, f, 'g', -1, bitSize)
+}
+
+// appendJSONDecimal appends decimal text s, quoted if QuoteDecimals is set
+func appendJSONDecimal(dst []byte, s string, opts *JSONOptions) []byte {
+	if opts.QuoteDecimals {
+		return appendJSONString(dst, s)
+	}
+	return append(dst, s...)
+}
+
+// appendJSONKey appends rendered map key as object key: strings are used as is, other values are quoted
+func appendJSONKey(dst []byte, key []byte) []byte {
+	if len(key) > 0 && key[0] == '"' {
+		return append(dst, key...)
+	}
+	return appendJSONString(dst, string(key))
+}
+
+// appendJSONObject appends {k1: v1, ...} from rendered keys and values
+func appendJSONObject(dst []byte, pairs [][2][]byte) []byte {
+	dst = append(dst, '{')
+	for i, p := range pairs {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendJSONKey(dst, p[0])
+		dst = append(dst, ':')
+		dst = append(dst, p[1]...)
+	}
+	return append(dst, '}')
+}
@@ -0,0 +1,103 @@
+package rowbinary
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	testJSON := func(tp Any, v any, expected string) {
+		b, err := AppendJSONAny(nil, tp, v, nil)
+		assert.NoError(err, tp.String())
+		assert.Equal(expected, string(b), tp.String())
+	}
+
+	testJSON(UInt8, uint8(42), `42`)
+	testJSON(Int32, int32(-42), `-42`)
+	testJSON(Int64, int64(-42), `"-42"`)
+	testJSON(UInt64, uint64(math.MaxUint64), `"18446744073709551615"`)
+	testJSON(Float64, 1.5, `1.5`)
+	testJSON(Float32, float32(math.Inf(1)), `null`)
+	testJSON(Bool, true, `true`)
+	testJSON(String, "a\"b/c\n\x01", `"a\"b\/c\n\u0001"`)
+	testJSON(FixedString(2), []byte("ab"), `"ab"`)
+	testJSON(UUID, uuid.MustParse("61f0c404-5cb3-11e7-907b-a6006ad3dba0"), `"61f0c404-5cb3-11e7-907b-a6006ad3dba0"`)
+	testJSON(IPv4, [4]byte{127, 0, 0, 1}, `"127.0.0.1"`)
+	testJSON(Date, ValueDate{Year: 2024, Month: 2, Day: 29}, `"2024-02-29"`)
+	testJSON(DateTime, time.Date(2024, 2, 29, 10, 11, 12, 0, time.UTC), `"2024-02-29 10:11:12"`)
+	testJSON(DateTime64TZ(3, "Asia/Shanghai"), time.Date(2024, 2, 29, 10, 11, 12, 345000000, time.UTC), `"2024-02-29 18:11:12.345"`)
+	testJSON(Decimal(9, 2), decimal.RequireFromString("1.5"), `1.50`)
+	testJSON(Nullable(String), (*string)(nil), `null`)
+	testJSON(Nullable(String), pointer("x"), `"x"`)
+	testJSON(Array(Int64), []int64{1, 2}, `["1","2"]`)
+	testJSON(Map(String, UInt8), map[string]uint8{"b": 2, "a": 1}, `{"a":1,"b":2}`)
+	testJSON(Map(UInt64, String), map[uint64]string{10: "x"}, `{"10":"x"}`)
+	testJSON(Map(UInt8, String), map[uint8]string{10: "x"}, `{"10":"x"}`)
+	testJSON(MapKV(String, UInt8), NewKV[string, uint8]().Append("b", 2).Append("a", 1), `{"b":2,"a":1}`)
+	testJSON(TupleAny(UInt8, String), []any{uint8(1), "x"}, `[1,"x"]`)
+	testJSON(TupleNamedAny(C("id", UInt8), C("name", String)), []any{uint8(1), "x"}, `{"id":1,"name":"x"}`)
+	testJSON(Variant(String, UInt64), Value{UInt64, uint64(1)}, `"1"`)
+	testJSON(Variant(String, UInt64), Value{}, `null`)
+	testJSON(Dynamic(0), Value{Array(String), []string{"x"}}, `["x"]`)
+	testJSON(Nothing, nil, `null`)
+
+	opts := &JSONOptions{QuoteDecimals: true}
+	b, err := AppendJSONAny(nil, TupleNamedAny(C("a", Int64), C("b", Decimal(9, 1))), []any{int64(1), decimal.New(15, -1)}, opts)
+	assert.NoError(err)
+	assert.Equal(`[1,"1.5"]`, string(b))
+
+	_, err = AppendJSONAny(nil, UInt8, "x", nil)
+	assert.Error(err)
+
+	b, err = AppendJSONEachRow(nil, []Column{C("a", UInt8), C("b", Nullable(Date))}, []any{uint8(1), (*ValueDate)(nil)}, nil)
+	assert.NoError(err)
+	assert.Equal("{\"a\":1,\"b\":null}\n", string(b))
+}
+
+func TestValue_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := json.Marshal([]Value{
+		{Int64, int64(1)},
+		{TupleNamedAny(C("s", String)), []any{"x"}},
+		{},
+	})
+	assert.NoError(err)
+	assert.Equal(`["1",{"s":"x"},null]`, string(b))
+
+	b, err = json.Marshal(Value{MapKV(String, UInt64), (*KV[string, uint64])(nil)})
+	assert.NoError(err)
+	assert.Equal(`null`, string(b))
+
+	b, err = AppendJSON(nil, MapKV(String, UInt64), nil, nil)
+	assert.NoError(err)
+	assert.Equal(`null`, string(b))
+
+	assert.Equal(`Array(UInt8)([1, 2])`, Value{Array(UInt8), []uint8{1, 2}}.String())
+	assert.Equal(`String('x')`, Value{String, "x"}.String())
+}
+
+func TestKV_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := json.Marshal(NewKV[string, int]().Append("b", 1).Append("a", 2))
+	assert.NoError(err)
+	assert.Equal(`{"b":1,"a":2}`, string(b))
+
+	b, err = json.Marshal(NewKV[int, Value]().Append(1, Value{UInt64, uint64(2)}))
+	assert.NoError(err)
+	assert.Equal(`{"1":"2"}`, string(b))
+
+	var kv *KV[string, int]
+	b, err = kv.MarshalJSON()
+	assert.NoError(err)
+	assert.Equal(`null`, string(b))
+}
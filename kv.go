@@ -1,6 +1,10 @@
 package rowbinary
 
-import "sort"
+import (
+	"encoding"
+	"encoding/json"
+	"sort"
+)
 
 type kvPair[K any, V any] struct {
 	key   K
@@ -47,3 +51,37 @@ func (kv *KV[K, V]) Each(f func(key K, value V) error) error {
 	}
 	return nil
 }
+
+// MarshalJSON renders pairs as JSON object preserving their order.
+// Keys which are not strings or encoding.TextMarshaler are rendered with json.Marshal and quoted.
+// Nil KV is rendered as null.
+func (kv *KV[K, V]) MarshalJSON() ([]byte, error) {
+	if kv == nil {
+		return []byte("null"), nil
+	}
+	pairs := make([][2][]byte, 0, len(kv.pairs))
+	for _, pair := range kv.pairs {
+		var kb []byte
+		switch k := any(pair.key).(type) {
+		case string:
+			kb = appendJSONString(nil, k)
+		case encoding.TextMarshaler:
+			text, err := k.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			kb = appendJSONString(nil, string(text))
+		default:
+			var err error
+			if kb, err = json.Marshal(k); err != nil {
+				return nil, err
+			}
+		}
+		vb, err := json.Marshal(pair.value)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, [2][]byte{kb, vb})
+	}
+	return appendJSONObject(nil, pairs), nil
+}
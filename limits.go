@@ -0,0 +1,199 @@
+package rowbinary
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DefaultMaxTypeDepth is the maximum nesting of binary type encodings used if Limits.MaxTypeDepth is not set
+const DefaultMaxTypeDepth = 1000
+
+// Limits restricts values decoded by FormatReader, see WithLimits. Zero field means no limit.
+type Limits struct {
+	// MaxCollectionLength limits number of elements of Array and Map values,
+	// also number of elements of Tuple, Variant and Enum types, number of columns in header,
+	// number of rows of Native block and size of LowCardinality dictionary in Native block
+	MaxCollectionLength int
+	// MaxStringSize limits size of String values in bytes, also names and types in header
+	MaxStringSize int
+	// MaxRowBytes limits size of encoded row
+	MaxRowBytes int64
+	// MaxTypeDepth limits nesting of binary type encodings in header and Dynamic values,
+	// DefaultMaxTypeDepth is used if zero
+	MaxTypeDepth int
+}
+
+// LimitError is returned when decoded data exceeds Limits
+type LimitError struct {
+	Limit string // name of exceeded Limits field
+	Value uint64
+	Max   int64
+}
+
+func (e LimitError) Error() string {
+	return fmt.Sprintf("%s exceeded: %d > %d", e.Limit, e.Value, e.Max)
+}
+
+type limitsType struct {
+	value Limits
+}
+
+var _ FormatOption = WithLimits(Limits{})
+
+// WithLimits makes FormatReader reject data exceeding limits with LimitError, e.g. for untrusted input.
+// Lengths are checked before memory for values is allocated.
+func WithLimits(limits Limits) limitsType {
+	return limitsType{
+		value: limits,
+	}
+}
+
+func (o limitsType) applyFormatOption(opts *formatOptions) {
+	opts.limits = o.value
+}
+
+func (o limitsType) applySelectOptions(opts *selectOptions) {
+	opts.formatOptions = append(opts.formatOptions, o)
+}
+
+func (o limitsType) applyClientOptions(opts *clientOptions) {
+	opts.defaultSelect = append(opts.defaultSelect, o)
+}
+
+// limitsReader is implemented by readers carrying Limits for decoded types
+type limitsReader interface {
+	readLimits() *Limits
+}
+
+func limitsOf(r Reader) *Limits {
+	if lr, ok := r.(limitsReader); ok {
+		return lr.readLimits()
+	}
+	return nil
+}
+
+// readLength reads length of value from r and checks it against limit max (field name of Limits)
+func readLength(r Reader, name string, max int) (int, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	return checkLength(n, name, max)
+}
+
+// checkLength checks length n against limit max (field name of Limits)
+func checkLength(n uint64, name string, max int) (int, error) {
+	if max > 0 && n > uint64(max) {
+		return 0, LimitError{Limit: name, Value: n, Max: int64(max)}
+	}
+	if n > uint64(math.MaxInt) {
+		return 0, LimitError{Limit: name, Value: n, Max: math.MaxInt}
+	}
+	return int(n), nil
+}
+
+// readStringLen reads length of String value
+func readStringLen(r Reader) (int, error) {
+	max := 0
+	if l := limitsOf(r); l != nil {
+		max = l.MaxStringSize
+	}
+	return readLength(r, "MaxStringSize", max)
+}
+
+// readCollectionLen reads number of elements of Array or Map value
+func readCollectionLen(r Reader) (int, error) {
+	max := 0
+	if l := limitsOf(r); l != nil {
+		max = l.MaxCollectionLength
+	}
+	return readLength(r, "MaxCollectionLength", max)
+}
+
+// collectionChunk limits number of elements allocated before they are decoded,
+// so corrupt collection length fails at the end of data instead of allocating memory for it
+const collectionChunk = 1024
+
+// preallocLen returns capacity to allocate for collection of n elements before decoding them
+func preallocLen(n int) int {
+	return min(n, collectionChunk)
+}
+
+// growLen returns length to extend collection of decoded length l to while decoding n elements,
+// collection is at most doubled at once
+func growLen(l, n int) int {
+	return l + min(n-l, max(l, collectionChunk))
+}
+
+// checkCollectionLen checks number of elements n of collection read from r, e.g. Native block rows
+func checkCollectionLen(r Reader, n uint64) (int, error) {
+	max := 0
+	if l := limitsOf(r); l != nil {
+		max = l.MaxCollectionLength
+	}
+	return checkLength(n, "MaxCollectionLength", max)
+}
+
+// maxTypeDepth returns maximum nesting of binary type encodings read from r
+func maxTypeDepth(r Reader) int {
+	if l := limitsOf(r); l != nil && l.MaxTypeDepth > 0 {
+		return l.MaxTypeDepth
+	}
+	return DefaultMaxTypeDepth
+}
+
+// limitReader passes Limits to types decoded from it and counts bytes of the current row
+type limitReader struct {
+	Reader
+	limits   *Limits
+	maxRow   int64 // 0 if row size is not limited
+	rowBytes int64
+}
+
+func (r *limitReader) readLimits() *Limits {
+	return r.limits
+}
+
+// consume counts n bytes of the current row
+func (r *limitReader) consume(n int) error {
+	r.rowBytes += int64(n)
+	if r.maxRow > 0 && r.rowBytes > r.maxRow {
+		return LimitError{Limit: "MaxRowBytes", Value: uint64(r.rowBytes), Max: r.maxRow}
+	}
+	return nil
+}
+
+func (r *limitReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if lerr := r.consume(n); lerr != nil {
+		return n, lerr
+	}
+	return n, err
+}
+
+func (r *limitReader) ReadByte() (byte, error) {
+	b, err := r.Reader.ReadByte()
+	if err != nil {
+		return b, err
+	}
+	return b, r.consume(1)
+}
+
+func (r *limitReader) UnreadByte() error {
+	err := r.Reader.UnreadByte()
+	if err == nil {
+		r.rowBytes--
+	}
+	return err
+}
+
+func (r *limitReader) Discard(n int) (int, error) {
+	// check before discarding, so value doesn't need to be read
+	if r.maxRow > 0 && r.rowBytes+int64(n) > r.maxRow {
+		return 0, LimitError{Limit: "MaxRowBytes", Value: uint64(r.rowBytes + int64(n)), Max: r.maxRow}
+	}
+	n, err := r.Reader.Discard(n)
+	r.rowBytes += int64(n)
+	return n, err
+}
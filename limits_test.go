@@ -0,0 +1,245 @@
+package rowbinary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func assertLimitError(t *testing.T, err error, limit string) {
+	t.Helper()
+	var le LimitError
+	if assert.True(t, errors.As(err, &le), "expected LimitError, got %v", err) {
+		assert.Equal(t, limit, le.Limit)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	// huge length prefix without data
+	huge := binary.AppendUvarint(nil, 1<<40)
+
+	t.Run("collection", func(t *testing.T) {
+		for _, tp := range []Any{Array(UInt8), Array(String), ArrayAny(String), Map(String, UInt8), MapAny(String, UInt8)} {
+			r := NewFormatReaderBytes(huge, C("x", tp), WithLimits(Limits{MaxCollectionLength: 100}))
+			assert.True(t, r.Next())
+			err := r.ReadRow(NewRow())
+			assertLimitError(t, err, "MaxCollectionLength")
+
+			var pe PositionError
+			assert.True(t, errors.As(err, &pe))
+
+			r = NewFormatReaderBytes(huge, C("x", tp), WithLimits(Limits{MaxCollectionLength: 100}))
+			assert.True(t, r.Next())
+			assertLimitError(t, r.SkipColumn(), "MaxCollectionLength")
+		}
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		// corrupt lengths fail at the end of data instead of allocating memory for them
+		huge := binary.AppendUvarint(nil, 1<<60)
+		for _, tp := range []Any{Array(UInt64), Array(String), ArrayAny(String), Map(String, UInt8), MapAny(String, UInt8), String} {
+			_, _, err := DecodeAny(tp, huge)
+			assert.Error(t, err, tp.String())
+		}
+
+		v, _, err := Decode(Array(UInt64), append(binary.AppendUvarint(nil, 3000), make([]byte, 3000*8)...))
+		assert.NoError(t, err)
+		assert.Len(t, v, 3000)
+
+		r := NewFormatReaderBytes(huge, RowBinaryWithNamesAndTypes)
+		assert.False(t, r.Next())
+		assert.Error(t, r.Err())
+	})
+
+	t.Run("string", func(t *testing.T) {
+		for _, tp := range []Any{String, StringBytes} {
+			r := NewFormatReaderBytes(huge, C("x", tp), WithLimits(Limits{MaxStringSize: 100}))
+			assert.True(t, r.Next())
+			assertLimitError(t, r.ReadRow(NewRow()), "MaxStringSize")
+		}
+
+		// skipped by projection
+		data := append(append([]byte{}, huge...), 1)
+		r := NewFormatReaderBytes(data, C("s", String), C("n", UInt8), WithProjection("n"), WithLimits(Limits{MaxStringSize: 100}))
+		assert.True(t, r.Next())
+		var n uint8
+		assertLimitError(t, Scan(r, UInt8, &n), "MaxStringSize")
+
+		r = NewFormatReaderBytes(huge, C("x", String), WithLimits(Limits{MaxStringSize: 100}))
+		assert.True(t, r.Next())
+		_, err := r.BorrowBytes()
+		assertLimitError(t, err, "MaxStringSize")
+
+		// header
+		r = NewFormatReaderBytes(append([]byte{1}, huge...), RowBinaryWithNames, C("x", String), WithLimits(Limits{MaxStringSize: 100}))
+		assert.False(t, r.Next())
+		assertLimitError(t, r.Err(), "MaxStringSize")
+
+		// values within limits
+		var buf bytes.Buffer
+		w := NewFormatWriter(&buf, C("x", String))
+		assert.NoError(t, w.WriteRow(strings.Repeat("a", 100)))
+		assert.NoError(t, w.Finish())
+		r = NewFormatReaderBytes(buf.Bytes(), C("x", String), WithLimits(Limits{MaxStringSize: 100}))
+		assert.True(t, r.Next())
+		var s string
+		assert.NoError(t, Scan(r, String, &s))
+	})
+
+	t.Run("row", func(t *testing.T) {
+		assert := assert.New(t)
+		limits := WithLimits(Limits{MaxRowBytes: 16})
+
+		var buf bytes.Buffer
+		w := NewFormatWriter(&buf, C("a", UInt64), C("b", UInt64))
+		for i := range 1000 {
+			assert.NoError(w.WriteRow(uint64(i), uint64(i)))
+		}
+		assert.NoError(w.Finish())
+
+		// limit is per row
+		r := NewFormatReaderBytes(buf.Bytes(), C("a", UInt64), C("b", UInt64), limits)
+		rows := 0
+		for r.Next() {
+			assert.NoError(r.SkipRow())
+			rows++
+		}
+		assert.NoError(r.Err())
+		assert.Equal(1000, rows)
+
+		r = NewFormatReaderBytes(buf.Bytes(), C("a", UInt64), C("b", UInt64), limits)
+		row := NewRow()
+		rows = 0
+		for r.Next() {
+			assert.NoError(r.ReadRow(row))
+			rows++
+		}
+		assert.NoError(r.Err())
+		assert.Equal(1000, rows)
+
+		r = NewFormatReaderBytes(buf.Bytes(), C("a", UInt64), C("b", UInt64), limits, WithProjection("b"))
+		b1 := NewColumnBuffer(UInt64, 0)
+		n, err := r.ReadBatch(10000, b1)
+		assert.NoError(err)
+		assert.Equal(1000, n)
+
+		r = NewFormatReaderBytes(buf.Bytes(), C("a", UInt64), C("b", UInt64), limits)
+		a, b := NewColumnBuffer(UInt64, 0), NewColumnBuffer(UInt64, 0)
+		n, err = r.ReadBatch(10000, a, b)
+		assert.NoError(err)
+		assert.Equal(1000, n)
+		assert.Equal(uint64(999), b.Values[999])
+
+		r = NewFormatReaderBytes(buf.Bytes(), C("a", UInt64), C("b", UInt64), WithLimits(Limits{MaxRowBytes: 15}))
+		_, err = r.ReadBatch(10000, a, b)
+		assertLimitError(t, err, "MaxRowBytes")
+
+		// header is not counted
+		buf.Reset()
+		w = NewFormatWriter(&buf, RowBinaryWithNamesAndTypes, C("a_long_column_name", String))
+		assert.NoError(w.WriteRow("0123456789"))
+		assert.NoError(w.WriteRow("0123456789abcdef"))
+		assert.NoError(w.Finish())
+
+		r = NewFormatReaderBytes(buf.Bytes(), RowBinaryWithNamesAndTypes, limits)
+		var s string
+		assert.True(r.Next())
+		assert.NoError(Scan(r, String, &s))
+		assert.True(r.Next())
+		assertLimitError(t, Scan(r, String, &s), "MaxRowBytes")
+	})
+
+	t.Run("native", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewFormatWriter(&buf, Native, C("s", String))
+		assert.NoError(t, w.WriteRow(strings.Repeat("a", 200)))
+		assert.NoError(t, w.Finish())
+
+		r := NewFormatReaderBytes(buf.Bytes(), Native, WithLimits(Limits{MaxStringSize: 100}))
+		assert.False(t, r.Next())
+		assertLimitError(t, r.Err(), "MaxStringSize")
+
+		r = NewFormatReaderBytes(buf.Bytes(), Native, WithLimits(Limits{MaxRowBytes: 100}))
+		assert.True(t, r.Next())
+		var s string
+		assertLimitError(t, Scan(r, String, &s), "MaxRowBytes")
+
+		// block header with one column followed by column data
+		block := func(numColumns, numRows uint64, tp string, data ...byte) []byte {
+			b := binary.AppendUvarint(nil, numColumns)
+			b = binary.AppendUvarint(b, numRows)
+			b = append(b, StringEncode("x")...)
+			b = append(b, StringEncode(tp)...)
+			return append(b, data...)
+		}
+		u64 := func(v ...uint64) []byte {
+			var b []byte
+			for _, x := range v {
+				b = binary.LittleEndian.AppendUint64(b, x)
+			}
+			return b
+		}
+
+		for _, tc := range []struct {
+			name   string
+			data   []byte
+			limits Limits
+			limit  string
+		}{
+			{"rows", block(1, 1<<40, "UInt64", 1, 2, 3), Limits{MaxCollectionLength: 1000}, "MaxCollectionLength"},
+			{"columns", block(1<<40, 1, "UInt64"), Limits{MaxCollectionLength: 1000}, "MaxCollectionLength"},
+			{"width", block(1, 1, "UInt64", u64(1)...), Limits{MaxRowBytes: 4}, "MaxRowBytes"},
+			{"offsets", block(1, 1, "Array(UInt8)", u64(1<<40)...), Limits{MaxCollectionLength: 1000}, "MaxCollectionLength"},
+			{"dictionary", block(1, 1, "LowCardinality(String)", u64(lowCardinalitySharedDictionariesWithAdditionalKeys, lowCardinalityHasAdditionalKeys, 1<<40)...), Limits{MaxCollectionLength: 1000}, "MaxCollectionLength"},
+		} {
+			r := NewFormatReaderBytes(tc.data, Native, WithLimits(tc.limits))
+			assert.False(t, r.Next(), tc.name)
+			assertLimitError(t, r.Err(), tc.limit)
+
+			if tc.name == "width" {
+				continue
+			}
+			// huge counts without limits fail at the end of data instead of allocating memory for them
+			r = NewFormatReaderBytes(tc.data, Native)
+			assert.False(t, r.Next(), tc.name)
+			assert.Error(t, r.Err(), tc.name)
+		}
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		nested := func(depth int) []byte {
+			b := bytes.Repeat(BinaryTypeArray[:], depth-1)
+			return append(b, BinaryTypeUInt8[0])
+		}
+
+		tp, err := DecodeBinaryType(newSliceReader(nested(DefaultMaxTypeDepth)))
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(tp.String(), "Array(Array("))
+
+		_, err = DecodeBinaryType(newSliceReader(nested(DefaultMaxTypeDepth + 1)))
+		assertLimitError(t, err, "MaxTypeDepth")
+
+		_, err = binaryTypeLen(newSliceReader(nested(DefaultMaxTypeDepth + 1)))
+		assertLimitError(t, err, "MaxTypeDepth")
+
+		// binary header
+		header := append([]byte{1, 1, 'x'}, nested(6)...)
+		r := NewFormatReaderBytes(header, RowBinaryWithNamesAndTypes, WithUseBinaryHeader(true), WithLimits(Limits{MaxTypeDepth: 5}))
+		assert.False(t, r.Next())
+		assertLimitError(t, r.Err(), "MaxTypeDepth")
+
+		r = NewFormatReaderBytes(header, RowBinaryWithNamesAndTypes, WithUseBinaryHeader(true), WithLimits(Limits{MaxTypeDepth: 6}))
+		assert.False(t, r.Next())
+		assert.NoError(t, r.Err())
+
+		// type of Dynamic value
+		r = NewFormatReaderBytes(nested(6), C("d", Dynamic(8)), WithLimits(Limits{MaxTypeDepth: 5}))
+		assert.True(t, r.Next())
+		var v Value
+		assertLimitError(t, Scan(r, Dynamic(8), &v), "MaxTypeDepth")
+	})
+}
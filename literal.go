@@ -0,0 +1,497 @@
+package rowbinary
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// LiteralType is implemented by types which can format Go values as ClickHouse SQL literals
+// and parse the text form back. All built-in types implement it, including composite ones.
+//
+// Custom types may implement it too; types created with MakeType and MakeTypeWrapAny
+// delegate to the wrapped implementation.
+type LiteralType[T any] interface {
+	// AppendLiteral appends SQL literal of v to dst
+	AppendLiteral(dst []byte, v T) ([]byte, error)
+	// ScanLiteral parses SQL literal at the current position of s
+	ScanLiteral(s *LiteralScanner, v *T) error
+}
+
+// literalAny is implemented by typeWrapper for literals of nested Any types
+type literalAny interface {
+	appendLiteralAny(dst []byte, v any) ([]byte, error)
+	scanLiteralAny(s *LiteralScanner) (any, error)
+}
+
+var _ LiteralType[uint8] = &typeWrapper[uint8]{}
+
+// FormatLiteral returns ClickHouse SQL literal of v, e.g. for "WHERE id IN (...)" or DEFAULT expressions.
+//
+// Strings are quoted and escaped, dates and times are quoted in 'YYYY-MM-DD hh:mm:ss' form
+// (UTC for types without time zone), arrays are rendered as [...], tuples as (...),
+// maps as map(k1, v1, ...) and nil Nullable values as NULL.
+func FormatLiteral[T any](tp Type[T], v T) (string, error) {
+	b, err := AppendLiteral(nil, tp, v)
+	return string(b), err
+}
+
+// AppendLiteral appends ClickHouse SQL literal of v to dst.
+func AppendLiteral[T any](dst []byte, tp Type[T], v T) ([]byte, error) {
+	lt, ok := tp.(LiteralType[T])
+	if !ok {
+		return dst, fmt.Errorf("literals are not supported for type %s", tp.String())
+	}
+	return lt.AppendLiteral(dst, v)
+}
+
+// ParseLiteral parses ClickHouse SQL literal s produced by FormatLiteral or written by hand.
+func ParseLiteral[T any](tp Type[T], s string) (T, error) {
+	var ret T
+	lt, ok := tp.(LiteralType[T])
+	if !ok {
+		return ret, fmt.Errorf("literals are not supported for type %s", tp.String())
+	}
+	sc := NewLiteralScanner(s)
+	if err := lt.ScanLiteral(sc, &ret); err != nil {
+		return ret, err
+	}
+	return ret, sc.End()
+}
+
+// FormatLiteralAny is like FormatLiteral for types known at runtime only.
+func FormatLiteralAny(tp Any, v any) (string, error) {
+	b, err := appendLiteralAny(nil, tp, v)
+	return string(b), err
+}
+
+// ParseLiteralAny is like ParseLiteral for types known at runtime only.
+func ParseLiteralAny(tp Any, s string) (any, error) {
+	sc := NewLiteralScanner(s)
+	v, err := scanLiteralAny(sc, tp)
+	if err != nil {
+		return nil, err
+	}
+	return v, sc.End()
+}
+
+func appendLiteralAny(dst []byte, tp Any, v any) ([]byte, error) {
+	l, ok := tp.(literalAny)
+	if !ok {
+		return dst, fmt.Errorf("literals are not supported for type %s", tp.String())
+	}
+	return l.appendLiteralAny(dst, v)
+}
+
+func scanLiteralAny(s *LiteralScanner, tp Any) (any, error) {
+	l, ok := tp.(literalAny)
+	if !ok {
+		return nil, fmt.Errorf("literals are not supported for type %s", tp.String())
+	}
+	return l.scanLiteralAny(s)
+}
+
+func (t *typeWrapper[T]) AppendLiteral(dst []byte, v T) ([]byte, error) {
+	lt, ok := t.PreType.(LiteralType[T])
+	if !ok {
+		return dst, fmt.Errorf("literals are not supported for type %s", t.tstr)
+	}
+	return lt.AppendLiteral(dst, v)
+}
+
+func (t *typeWrapper[T]) ScanLiteral(s *LiteralScanner, v *T) error {
+	lt, ok := t.PreType.(LiteralType[T])
+	if !ok {
+		return fmt.Errorf("literals are not supported for type %s", t.tstr)
+	}
+	return lt.ScanLiteral(s, v)
+}
+
+func (t *typeWrapper[T]) appendLiteralAny(dst []byte, v any) ([]byte, error) {
+	value, ok := v.(T)
+	if !ok {
+		if v != nil || !literalNilable[T]() {
+			return dst, newTypeMismatchError[T](v)
+		}
+	}
+	return t.AppendLiteral(dst, value)
+}
+
+func (t *typeWrapper[T]) scanLiteralAny(s *LiteralScanner) (any, error) {
+	var value T
+	err := t.ScanLiteral(s, &value)
+	return value, err
+}
+
+func (t typeWrapperAny[T]) AppendLiteral(dst []byte, v T) ([]byte, error) {
+	lt, ok := t.BaseType.(LiteralType[T])
+	if !ok {
+		return dst, fmt.Errorf("literals are not supported for type %s", t.String())
+	}
+	return lt.AppendLiteral(dst, v)
+}
+
+func (t typeWrapperAny[T]) ScanLiteral(s *LiteralScanner, v *T) error {
+	lt, ok := t.BaseType.(LiteralType[T])
+	if !ok {
+		return fmt.Errorf("literals are not supported for type %s", t.String())
+	}
+	return lt.ScanLiteral(s, v)
+}
+
+func (t *customType[T]) AppendLiteral(dst []byte, v T) ([]byte, error) {
+	return AppendLiteral(dst, t.Type, v)
+}
+
+func (t *customType[T]) ScanLiteral(s *LiteralScanner, v *T) error {
+	lt, ok := t.Type.(LiteralType[T])
+	if !ok {
+		return fmt.Errorf("literals are not supported for type %s", t.name)
+	}
+	return lt.ScanLiteral(s, v)
+}
+
+// literalNilable reports whether nil is a valid value of T (rendered as NULL)
+func literalNilable[T any]() bool {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// AppendQuotedLiteral appends s as a quoted and escaped ClickHouse string literal.
+func AppendQuotedLiteral(dst []byte, s string) []byte {
+	dst = append(dst, '\'')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			dst = append(dst, '\\', '\\')
+		case '\'':
+			dst = append(dst, '\\', '\'')
+		case '\n':
+			dst = append(dst, '\\', 'n')
+		case '\r':
+			dst = append(dst, '\\', 'r')
+		case '\t':
+			dst = append(dst, '\\', 't')
+		case 0:
+			dst = append(dst, '\\', '0')
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return append(dst, '\'')
+}
+
+// LiteralScanner reads SQL literals from a string.
+// Used by ScanLiteral implementations, whitespace between tokens is skipped.
+type LiteralScanner struct {
+	s   string
+	pos int
+}
+
+// NewLiteralScanner creates a LiteralScanner for s.
+func NewLiteralScanner(s string) *LiteralScanner {
+	return &LiteralScanner{s: s}
+}
+
+func (s *LiteralScanner) skipSpace() {
+	for s.pos < len(s.s) && strings.IndexByte(" \t\r\n", s.s[s.pos]) >= 0 {
+		s.pos++
+	}
+}
+
+// Errorf returns parse error with the current position.
+func (s *LiteralScanner) Errorf(format string, args ...any) error {
+	return fmt.Errorf("can't parse literal %q at position %d: %s", s.s, s.pos, fmt.Sprintf(format, args...))
+}
+
+// Peek returns the next non-space byte or 0 at the end of input.
+func (s *LiteralScanner) Peek() byte {
+	s.skipSpace()
+	if s.pos >= len(s.s) {
+		return 0
+	}
+	return s.s[s.pos]
+}
+
+// Consume skips c if it is the next non-space byte.
+func (s *LiteralScanner) Consume(c byte) bool {
+	if s.Peek() == c && c != 0 {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// Expect skips c or returns error if the next non-space byte is different.
+func (s *LiteralScanner) Expect(c byte) error {
+	if !s.Consume(c) {
+		return s.Errorf("expected %q", c)
+	}
+	return nil
+}
+
+// ConsumeKeyword skips case-insensitive keyword kw if it is the next word.
+func (s *LiteralScanner) ConsumeKeyword(kw string) bool {
+	s.skipSpace()
+	end := s.pos + len(kw)
+	if end > len(s.s) || !strings.EqualFold(s.s[s.pos:end], kw) {
+		return false
+	}
+	if end < len(s.s) && isLiteralWordByte(s.s[end]) {
+		return false
+	}
+	s.pos = end
+	return true
+}
+
+// End returns error if there are unparsed bytes left.
+func (s *LiteralScanner) End() error {
+	if s.Peek() != 0 {
+		return s.Errorf("unexpected trailing data")
+	}
+	return nil
+}
+
+func isLiteralWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == '+' || c == '-'
+}
+
+// Word returns the next unquoted token: number, NULL, true, nan, etc.
+func (s *LiteralScanner) Word() (string, error) {
+	s.skipSpace()
+	start := s.pos
+	for s.pos < len(s.s) && isLiteralWordByte(s.s[s.pos]) {
+		s.pos++
+	}
+	if start == s.pos {
+		return "", s.Errorf("expected value")
+	}
+	return s.s[start:s.pos], nil
+}
+
+// Quoted returns the unescaped content of the next quoted string.
+func (s *LiteralScanner) Quoted() (string, error) {
+	if err := s.Expect('\''); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for s.pos < len(s.s) {
+		c := s.s[s.pos]
+		s.pos++
+		switch c {
+		case '\'':
+			// SQL style escaping of quote by doubling
+			if s.pos < len(s.s) && s.s[s.pos] == '\'' {
+				b.WriteByte('\'')
+				s.pos++
+				continue
+			}
+			return b.String(), nil
+		case '\\':
+			if s.pos >= len(s.s) {
+				return "", s.Errorf("unterminated escape sequence")
+			}
+			e := s.s[s.pos]
+			s.pos++
+			switch e {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '0':
+				b.WriteByte(0)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'x':
+				if s.pos+2 > len(s.s) {
+					return "", s.Errorf("invalid escape sequence")
+				}
+				n, err := strconv.ParseUint(s.s[s.pos:s.pos+2], 16, 8)
+				if err != nil {
+					return "", s.Errorf("invalid escape sequence")
+				}
+				b.WriteByte(byte(n))
+				s.pos += 2
+			default:
+				b.WriteByte(e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", s.Errorf("unterminated string")
+}
+
+// Int parses signed integer of given bit size.
+func (s *LiteralScanner) Int(bitSize int) (int64, error) {
+	w, err := s.Word()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(w, 10, bitSize)
+	if err != nil {
+		return 0, s.Errorf("%s", err)
+	}
+	return n, nil
+}
+
+// Uint parses unsigned integer of given bit size.
+func (s *LiteralScanner) Uint(bitSize int) (uint64, error) {
+	w, err := s.Word()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(w, 10, bitSize)
+	if err != nil {
+		return 0, s.Errorf("%s", err)
+	}
+	return n, nil
+}
+
+// Float parses floating point number including nan and inf.
+func (s *LiteralScanner) Float(bitSize int) (float64, error) {
+	w, err := s.Word()
+	if err != nil {
+		return 0, err
+	}
+	switch strings.ToLower(w) {
+	case "nan", "+nan", "-nan":
+		return math.NaN(), nil
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	}
+	f, err := strconv.ParseFloat(w, bitSize)
+	if err != nil {
+		return 0, s.Errorf("%s", err)
+	}
+	return f, nil
+}
+
+func appendFloatLiteral(dst []byte, f float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(dst, "nan"...)
+	case math.IsInf(f, 1):
+		return append(dst, "inf"...)
+	case math.IsInf(f, -1):
+		return append(dst, "-inf"...)
+	}
+	return strconv.AppendFloat(dst, f, 'g', -1, bitSize)
+}
+
+// scanList parses comma separated list of values until close byte.
+// Opening byte must be consumed by caller
+func (s *LiteralScanner) scanList(close byte, item func() error) error {
+	if s.Consume(close) {
+		return nil
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+		if s.Consume(close) {
+			return nil
+		}
+		if err := s.Expect(','); err != nil {
+			return err
+		}
+	}
+}
+
+func scanLiteral[T any](s *LiteralScanner, tp Type[T], v *T) error {
+	lt, ok := tp.(LiteralType[T])
+	if !ok {
+		return fmt.Errorf("literals are not supported for type %s", tp.String())
+	}
+	return lt.ScanLiteral(s, v)
+}
+
+// appendMapLiteral appends map(k1, v1, ...) from rendered keys and values
+func appendMapLiteral(dst []byte, pairs [][2][]byte) []byte {
+	dst = append(dst, "map("...)
+	for i, p := range pairs {
+		if i > 0 {
+			dst = append(dst, ", "...)
+		}
+		dst = append(dst, p[0]...)
+		dst = append(dst, ", "...)
+		dst = append(dst, p[1]...)
+	}
+	return append(dst, ')')
+}
+
+// scanMap parses map(k1, v1, ...) or {k1: v1, ...} calling key and value for every pair
+func (s *LiteralScanner) scanMap(key func() error, value func() error) error {
+	var close, sep byte
+	if s.ConsumeKeyword("map") {
+		if err := s.Expect('('); err != nil {
+			return err
+		}
+		close, sep = ')', ','
+	} else if s.Consume('{') {
+		close, sep = '}', ':'
+	} else {
+		return s.Errorf("expected map")
+	}
+	return s.scanList(close, func() error {
+		if err := key(); err != nil {
+			return err
+		}
+		if err := s.Expect(sep); err != nil {
+			return err
+		}
+		return value()
+	})
+}
+
+// scanTuple parses (v1, ...) or tuple(v1, ...) calling item with element index
+func (s *LiteralScanner) scanTuple(n int, item func(i int) error) error {
+	s.ConsumeKeyword("tuple")
+	if err := s.Expect('('); err != nil {
+		return err
+	}
+	i := 0
+	err := s.scanList(')', func() error {
+		if i >= n {
+			return s.Errorf("too many tuple elements, expected %d", n)
+		}
+		i++
+		return item(i - 1)
+	})
+	if err != nil {
+		return err
+	}
+	if i != n {
+		return s.Errorf("invalid tuple length %d, expected %d", i, n)
+	}
+	return nil
+}
+
+// appendTupleLiteral appends (v1, ...) or tuple(v1) for single element tuples
+func appendTupleLiteral(dst []byte, n int, item func(dst []byte, i int) ([]byte, error)) ([]byte, error) {
+	if n == 1 {
+		dst = append(dst, "tuple"...)
+	}
+	dst = append(dst, '(')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			dst = append(dst, ", "...)
+		}
+		var err error
+		if dst, err = item(dst, i); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ')'), nil
+}
@@ -0,0 +1,122 @@
+package rowbinary
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLiteral[T any](t *testing.T, tp Type[T], value T, expected string) {
+	t.Helper()
+	s, err := FormatLiteral(tp, value)
+	assert.NoError(t, err, tp.String())
+	assert.Equal(t, expected, s, tp.String())
+
+	parsed, err := ParseLiteral(tp, s)
+	assert.NoError(t, err, tp.String())
+	assert.Equal(t, value, parsed, tp.String())
+}
+
+func TestLiteral(t *testing.T) {
+	testLiteral(t, UInt8, 42, "42")
+	testLiteral(t, Int64, -42, "-42")
+	testLiteral(t, Float64, 1.5, "1.5")
+	testLiteral(t, Bool, true, "true")
+	testLiteral(t, String, "it's\n\\", `'it\'s\n\\'`)
+	testLiteral(t, StringBytes, []byte("hello"), "'hello'")
+	testLiteral(t, FixedString(3), []byte("ab\x00"), `'ab\0'`)
+	testLiteral(t, Date, ValueDate{2023, 11, 22}, "'2023-11-22'")
+	testLiteral(t, Date32, ValueDate{1900, 1, 1}, "'1900-01-01'")
+	testLiteral(t, DateTime, time.Date(2023, 11, 22, 20, 49, 31, 0, time.UTC), "toDateTime('2023-11-22 20:49:31', 'UTC')")
+	testLiteral(t, DateTime64(3), time.Date(2023, 11, 22, 20, 49, 31, 123000000, time.UTC), "toDateTime64('2023-11-22 20:49:31.123', 3, 'UTC')")
+	testLiteral(t, DateTimeTZ("Asia/Shanghai"), time.Date(2025, 3, 11, 23, 43, 2, 0, must(time.LoadLocation("Asia/Shanghai"))), "'2025-03-11 23:43:02'")
+	testLiteral(t, Decimal(9, 4), decimal.New(42000, -4), "toDecimal32('4.2000', 4)")
+	testLiteral(t, Decimal(18, 2), decimal.New(-150, -2), "toDecimal64('-1.50', 2)")
+	testLiteral(t, Decimal(38, 10), decimal.RequireFromString("1234567890123456789.0123456789"), "toDecimal128('1234567890123456789.0123456789', 10)")
+	testLiteral(t, Decimal(76, 30), decimal.RequireFromString("-123456789012345678901234567890.123456789012345678901234567891"), "toDecimal256('-123456789012345678901234567890.123456789012345678901234567891', 30)")
+	testLiteral(t, UUID, uuid.MustParse("258b07b7-daa1-4c80-8062-58a2e07c2601"), "'258b07b7-daa1-4c80-8062-58a2e07c2601'")
+	testLiteral(t, IPv4, netip.MustParseAddr("127.0.0.1").As4(), "'127.0.0.1'")
+	testLiteral(t, IPv6, netip.MustParseAddr("2001:db8::68").As16(), "'2001:db8::68'")
+	testLiteral(t, Enum8(map[string]int8{"android": 1, "ios": 2}), "ios", "'ios'")
+	testLiteral(t, IntervalDay, 42, "INTERVAL 42 DAY")
+	testLiteral(t, Array(UInt32), []uint32{1, 2, 3}, "[1, 2, 3]")
+	testLiteral(t, Array(Array(String)), [][]string{{"a"}, {}}, "[['a'], []]")
+	testLiteral(t, ArrayAny(String), []any{"a", "b"}, "['a', 'b']")
+	testLiteral(t, Map(String, UInt64), map[string]uint64{"b": 2, "a": 1}, "map('a', 1, 'b', 2)")
+	testLiteral(t, MapAny(String, UInt64), map[any]any{"a": uint64(1)}, "map('a', 1)")
+	testLiteral(t, MapKV(String, UInt64), NewKV[string, uint64]().Append("b", 2).Append("a", 1), "map('b', 2, 'a', 1)")
+	testLiteral(t, Nullable(Int32), pointer(int32(-42)), "-42")
+	testLiteral(t, Nullable(Int32), nil, "NULL")
+	testLiteral(t, NullableAny(String), pointer(any("x")), "'x'")
+	testLiteral(t, LowCardinality(String), "x", "'x'")
+	testLiteral(t, TupleAny(UInt32, String), []any{uint32(42), "x"}, "(42, 'x')")
+	testLiteral(t, TupleAny(UInt32), []any{uint32(42)}, "tuple(42)")
+	testLiteral(t, TupleNamedAny(C("i", UInt32), C("s", Nullable(String))), []any{uint32(42), (*string)(nil)}, "(42, NULL)")
+	testLiteral(t, Point, []any{1.5, 2.5}, "(1.5, 2.5)")
+	testLiteral(t, Variant(UInt32, String), Value{String, "x"}, "'x'")
+	testLiteral(t, Variant(UInt32, String), Value{UInt32, uint32(1)}, "1")
+	testLiteral(t, Dynamic(0), Value{Int64, int64(1)}, "1")
+	testLiteral(t, Dynamic(0), Value{}, "NULL")
+}
+
+func TestLiteral_Parse(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := ParseLiteral(Map(String, UInt8), "{'a': 1, 'b' : 2}")
+	assert.NoError(err)
+	assert.Equal(map[string]uint8{"a": 1, "b": 2}, m)
+
+	s, err := ParseLiteral(String, `'it''s \x41'`)
+	assert.NoError(err)
+	assert.Equal("it's A", s)
+
+	tm, err := ParseLiteral(DateTime, "1700000000")
+	assert.NoError(err)
+	assert.Equal(time.Unix(1700000000, 0).UTC(), tm)
+
+	tm, err = ParseLiteral(DateTime, "toDateTime('2023-11-22 23:49:31', 'Europe/Moscow')")
+	assert.NoError(err)
+	assert.Equal(time.Date(2023, 11, 22, 20, 49, 31, 0, time.UTC), tm)
+
+	tm, err = ParseLiteral(DateTime64(3), "'2023-11-22 20:49:31.123'")
+	assert.NoError(err)
+	assert.Equal(time.Date(2023, 11, 22, 20, 49, 31, 123000000, time.UTC), tm)
+
+	d, err := ParseLiteral(Decimal(9, 2), "1.50")
+	assert.NoError(err)
+	assert.Equal("1.50", d.StringFixed(2))
+
+	_, err = ParseLiteral(Decimal(9, 2), "1.505")
+	assert.ErrorContains(err, "more than 2 digits after decimal point")
+
+	_, err = FormatLiteral(Decimal(9, 2), decimal.RequireFromString("1.505"))
+	assert.ErrorContains(err, "more than 2 digits after decimal point")
+
+	kv, err := FormatLiteral(MapKV(String, UInt64), nil)
+	assert.NoError(err)
+	assert.Equal("map()", kv)
+
+	e, err := ParseLiteral(Enum8(map[string]int8{"android": 1, "ios": 2}), "2")
+	assert.NoError(err)
+	assert.Equal("ios", e)
+
+	v, err := ParseLiteralAny(ArrayAny(NullableAny(UInt8)), "[1, NULL]")
+	assert.NoError(err)
+	assert.Equal([]any{pointer(any(uint8(1))), (*any)(nil)}, v)
+
+	_, err = ParseLiteral(UInt8, "256")
+	assert.Error(err)
+
+	_, err = ParseLiteral(Array(UInt8), "[1, 2")
+	assert.Error(err)
+
+	_, err = ParseLiteral(UInt8, "1 2")
+	assert.ErrorContains(err, "trailing data")
+
+	_, err = ParseLiteral(TupleAny(UInt8, UInt8), "(1)")
+	assert.ErrorContains(err, "invalid tuple length")
+}
@@ -28,6 +28,38 @@ func (t typeLowCardinality[V]) Write(w Writer, value V) error {
 	return t.valueType.Write(w, value)
 }
 
+func (t typeLowCardinality[V]) Append(dst []byte, v V) ([]byte, error) {
+	return Append(dst, t.valueType, v)
+}
+
 func (t typeLowCardinality[V]) Scan(r Reader, v *V) (err error) {
 	return t.valueType.Scan(r, v)
 }
+
+func (t typeLowCardinality[V]) Skip(r Reader) error {
+	return Skip(r, t.valueType)
+}
+
+func (t typeLowCardinality[V]) coerce(v any) (V, error) {
+	return Coerce(t.valueType, v)
+}
+
+func (t typeLowCardinality[V]) AppendLiteral(dst []byte, v V) ([]byte, error) {
+	return AppendLiteral(dst, t.valueType, v)
+}
+
+func (t typeLowCardinality[V]) ScanLiteral(s *LiteralScanner, v *V) error {
+	return scanLiteral(s, t.valueType, v)
+}
+
+func (t typeLowCardinality[V]) AppendJSON(dst []byte, v V, opts *JSONOptions) ([]byte, error) {
+	return AppendJSON(dst, t.valueType, v, opts)
+}
+
+// stripLowCardinality returns binary encoding of type bin without LowCardinality wrappers
+func stripLowCardinality(bin []byte) []byte {
+	for len(bin) > 0 && [1]byte{bin[0]} == BinaryTypeLowCardinality {
+		bin = bin[1:]
+	}
+	return bin
+}
@@ -29,6 +29,31 @@ func (t typeLowCardinalityAny) Write(w Writer, value any) error {
 	return t.valueType.WriteAny(w, value)
 }
 
+func (t typeLowCardinalityAny) Append(dst []byte, v any) ([]byte, error) {
+	return appendAny(dst, t.valueType, v)
+}
+
 func (t typeLowCardinalityAny) Scan(r Reader, v *any) (err error) {
 	return t.valueType.ScanAny(r, v)
 }
+
+func (t typeLowCardinalityAny) Skip(r Reader) error {
+	return Skip(r, t.valueType)
+}
+
+func (t typeLowCardinalityAny) coerce(v any) (any, error) {
+	return coerceAny(t.valueType, v)
+}
+
+func (t typeLowCardinalityAny) AppendLiteral(dst []byte, v any) ([]byte, error) {
+	return appendLiteralAny(dst, t.valueType, v)
+}
+
+func (t typeLowCardinalityAny) ScanLiteral(s *LiteralScanner, v *any) (err error) {
+	*v, err = scanLiteralAny(s, t.valueType)
+	return err
+}
+
+func (t typeLowCardinalityAny) AppendJSON(dst []byte, v any, opts *JSONOptions) ([]byte, error) {
+	return appendJSONAny(dst, t.valueType, v, opts)
+}
@@ -72,7 +72,7 @@ func (t typeWrapperAny[T]) ScanAny(r Reader, v any) error {
 func (t typeWrapperAny[T]) WriteAny(w Writer, v any) error {
 	value, ok := v.(T)
 	if !ok {
-		return TypeMismatchError{}
+		return newTypeMismatchError[T](v)
 	}
 	return t.Write(w, value)
 }
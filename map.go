@@ -1,8 +1,10 @@
 package rowbinary
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"reflect"
 	"slices"
 )
 
@@ -61,15 +63,29 @@ func (t typeMap[K, V]) Write(w Writer, value map[K]V) error {
 	return nil
 }
 
+func (t typeMap[K, V]) Append(dst []byte, v map[K]V) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+	var err error
+	for key, value := range v {
+		if dst, err = Append(dst, t.keyType, key); err != nil {
+			return dst, err
+		}
+		if dst, err = Append(dst, t.valueType, value); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
 func (t typeMap[K, V]) Scan(r Reader, ret *map[K]V) (err error) {
 	*ret = make(map[K]V)
 
-	n, err := binary.ReadUvarint(r)
+	n, err := readCollectionLen(r)
 	if err != nil {
 		return err
 	}
 
-	for i := uint64(0); i < n; i++ {
+	for i := 0; i < n; i++ {
 		var k K
 		var v V
 		err = t.keyType.Scan(r, &k)
@@ -86,3 +102,88 @@ func (t typeMap[K, V]) Scan(r Reader, ret *map[K]V) (err error) {
 
 	return nil
 }
+
+func (t typeMap[K, V]) Skip(r Reader) error {
+	n, err := readCollectionLen(r)
+	if err != nil {
+		return err
+	}
+	return skipPairs(r, t.keyType, t.valueType, n)
+}
+
+func (t typeMap[K, V]) coerce(v any) (map[K]V, error) {
+	if value, ok := v.(map[K]V); ok {
+		return value, nil
+	}
+	src, ok := coerceDeref(v)
+	if !ok || src.Kind() != reflect.Map {
+		return nil, newTypeMismatchError[map[K]V](v)
+	}
+	ret := make(map[K]V, src.Len())
+	iter := src.MapRange()
+	for iter.Next() {
+		k, err := Coerce(t.keyType, iter.Key().Interface())
+		if err != nil {
+			return nil, err
+		}
+		ret[k], err = Coerce(t.valueType, iter.Value().Interface())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// AppendLiteral renders map(k1, v1, ...) with keys sorted by their literals
+func (t typeMap[K, V]) AppendLiteral(dst []byte, value map[K]V) ([]byte, error) {
+	pairs := make([][2][]byte, 0, len(value))
+	for k, v := range value {
+		kb, err := AppendLiteral(nil, t.keyType, k)
+		if err != nil {
+			return dst, err
+		}
+		vb, err := AppendLiteral(nil, t.valueType, v)
+		if err != nil {
+			return dst, err
+		}
+		pairs = append(pairs, [2][]byte{kb, vb})
+	}
+	slices.SortFunc(pairs, func(a, b [2][]byte) int {
+		return bytes.Compare(a[0], b[0])
+	})
+	return appendMapLiteral(dst, pairs), nil
+}
+
+func (t typeMap[K, V]) ScanLiteral(s *LiteralScanner, ret *map[K]V) error {
+	*ret = make(map[K]V)
+	var k K
+	return s.scanMap(func() error {
+		return scanLiteral(s, t.keyType, &k)
+	}, func() error {
+		var v V
+		if err := scanLiteral(s, t.valueType, &v); err != nil {
+			return err
+		}
+		(*ret)[k] = v
+		return nil
+	})
+}
+
+func (t typeMap[K, V]) AppendJSON(dst []byte, value map[K]V, opts *JSONOptions) ([]byte, error) {
+	pairs := make([][2][]byte, 0, len(value))
+	for k, v := range value {
+		kb, err := AppendJSON(nil, t.keyType, k, opts)
+		if err != nil {
+			return dst, err
+		}
+		vb, err := AppendJSON(nil, t.valueType, v, opts)
+		if err != nil {
+			return dst, err
+		}
+		pairs = append(pairs, [2][]byte{kb, vb})
+	}
+	slices.SortFunc(pairs, func(a, b [2][]byte) int {
+		return bytes.Compare(a[0], b[0])
+	})
+	return appendJSONObject(dst, pairs), nil
+}
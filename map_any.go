@@ -1,8 +1,10 @@
 package rowbinary
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"reflect"
 	"slices"
 )
 
@@ -63,13 +65,27 @@ func (t typeMapAny) Write(w Writer, value map[any]any) error {
 	return nil
 }
 
+func (t typeMapAny) Append(dst []byte, v map[any]any) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+	var err error
+	for key, value := range v {
+		if dst, err = appendAny(dst, t.keyType, key); err != nil {
+			return dst, err
+		}
+		if dst, err = appendAny(dst, t.valueType, value); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
 func (t typeMapAny) Scan(r Reader, ret *map[any]any) (err error) {
-	n, err := binary.ReadUvarint(r)
+	n, err := readCollectionLen(r)
 	if err != nil {
 		return err
 	}
-	*ret = make(map[any]any, int(n))
-	for i := uint64(0); i < n; i++ {
+	*ret = make(map[any]any, preallocLen(n))
+	for i := 0; i < n; i++ {
 		var k, v any
 		err = t.keyType.ScanAny(r, &k)
 		if err != nil {
@@ -85,3 +101,86 @@ func (t typeMapAny) Scan(r Reader, ret *map[any]any) (err error) {
 
 	return nil
 }
+
+func (t typeMapAny) Skip(r Reader) error {
+	n, err := readCollectionLen(r)
+	if err != nil {
+		return err
+	}
+	return skipPairs(r, t.keyType, t.valueType, n)
+}
+
+func (t typeMapAny) coerce(v any) (map[any]any, error) {
+	src, ok := coerceDeref(v)
+	if !ok || src.Kind() != reflect.Map {
+		return nil, newTypeMismatchError[map[any]any](v)
+	}
+	ret := make(map[any]any, src.Len())
+	iter := src.MapRange()
+	for iter.Next() {
+		k, err := coerceAny(t.keyType, iter.Key().Interface())
+		if err != nil {
+			return nil, err
+		}
+		ret[k], err = coerceAny(t.valueType, iter.Value().Interface())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// AppendLiteral renders map(k1, v1, ...) with keys sorted by their literals
+func (t typeMapAny) AppendLiteral(dst []byte, value map[any]any) ([]byte, error) {
+	pairs := make([][2][]byte, 0, len(value))
+	for k, v := range value {
+		kb, err := appendLiteralAny(nil, t.keyType, k)
+		if err != nil {
+			return dst, err
+		}
+		vb, err := appendLiteralAny(nil, t.valueType, v)
+		if err != nil {
+			return dst, err
+		}
+		pairs = append(pairs, [2][]byte{kb, vb})
+	}
+	slices.SortFunc(pairs, func(a, b [2][]byte) int {
+		return bytes.Compare(a[0], b[0])
+	})
+	return appendMapLiteral(dst, pairs), nil
+}
+
+func (t typeMapAny) ScanLiteral(s *LiteralScanner, ret *map[any]any) error {
+	*ret = make(map[any]any)
+	var k any
+	return s.scanMap(func() (err error) {
+		k, err = scanLiteralAny(s, t.keyType)
+		return err
+	}, func() error {
+		v, err := scanLiteralAny(s, t.valueType)
+		if err != nil {
+			return err
+		}
+		(*ret)[k] = v
+		return nil
+	})
+}
+
+func (t typeMapAny) AppendJSON(dst []byte, value map[any]any, opts *JSONOptions) ([]byte, error) {
+	pairs := make([][2][]byte, 0, len(value))
+	for k, v := range value {
+		kb, err := appendJSONAny(nil, t.keyType, k, opts)
+		if err != nil {
+			return dst, err
+		}
+		vb, err := appendJSONAny(nil, t.valueType, v, opts)
+		if err != nil {
+			return dst, err
+		}
+		pairs = append(pairs, [2][]byte{kb, vb})
+	}
+	slices.SortFunc(pairs, func(a, b [2][]byte) int {
+		return bytes.Compare(a[0], b[0])
+	})
+	return appendJSONObject(dst, pairs), nil
+}
@@ -46,18 +46,31 @@ func (t typeMapKV[K, V]) Write(w Writer, value *KV[K, V]) error {
 	})
 }
 
+func (t typeMapKV[K, V]) Append(dst []byte, v *KV[K, V]) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(v.Len()))
+	err := v.Each(func(key K, value V) error {
+		var err error
+		if dst, err = Append(dst, t.keyType, key); err != nil {
+			return err
+		}
+		dst, err = Append(dst, t.valueType, value)
+		return err
+	})
+	return dst, err
+}
+
 func (t typeMapKV[K, V]) Scan(r Reader, ret **KV[K, V]) error {
 	if *ret == nil {
 		*ret = NewKV[K, V]()
 	}
 	(*ret).Reset()
 
-	n, err := binary.ReadUvarint(r)
+	n, err := readCollectionLen(r)
 	if err != nil {
 		return err
 	}
 
-	for i := uint64(0); i < n; i++ {
+	for i := 0; i < n; i++ {
 		var k K
 		var v V
 		err = t.keyType.Scan(r, &k)
@@ -74,3 +87,79 @@ func (t typeMapKV[K, V]) Scan(r Reader, ret **KV[K, V]) error {
 
 	return nil
 }
+
+func (t typeMapKV[K, V]) Skip(r Reader) error {
+	n, err := readCollectionLen(r)
+	if err != nil {
+		return err
+	}
+	return skipPairs(r, t.keyType, t.valueType, n)
+}
+
+// AppendLiteral renders map(k1, v1, ...) preserving order of pairs, nil KV is rendered as empty map
+func (t typeMapKV[K, V]) AppendLiteral(dst []byte, value *KV[K, V]) ([]byte, error) {
+	dst = append(dst, "map("...)
+	if value == nil {
+		return append(dst, ')'), nil
+	}
+	i := 0
+	err := value.Each(func(k K, v V) error {
+		if i > 0 {
+			dst = append(dst, ", "...)
+		}
+		i++
+		var err error
+		if dst, err = AppendLiteral(dst, t.keyType, k); err != nil {
+			return err
+		}
+		dst = append(dst, ", "...)
+		dst, err = AppendLiteral(dst, t.valueType, v)
+		return err
+	})
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, ')'), nil
+}
+
+func (t typeMapKV[K, V]) ScanLiteral(s *LiteralScanner, ret **KV[K, V]) error {
+	if *ret == nil {
+		*ret = NewKV[K, V]()
+	}
+	(*ret).Reset()
+	var k K
+	return s.scanMap(func() error {
+		return scanLiteral(s, t.keyType, &k)
+	}, func() error {
+		var v V
+		if err := scanLiteral(s, t.valueType, &v); err != nil {
+			return err
+		}
+		(*ret).Append(k, v)
+		return nil
+	})
+}
+
+// AppendJSON renders pairs as JSON object preserving their order, nil KV is rendered as null
+func (t typeMapKV[K, V]) AppendJSON(dst []byte, value *KV[K, V], opts *JSONOptions) ([]byte, error) {
+	if value == nil {
+		return append(dst, "null"...), nil
+	}
+	pairs := make([][2][]byte, 0, value.Len())
+	err := value.Each(func(k K, v V) error {
+		kb, err := AppendJSON(nil, t.keyType, k, opts)
+		if err != nil {
+			return err
+		}
+		vb, err := AppendJSON(nil, t.valueType, v, opts)
+		if err != nil {
+			return err
+		}
+		pairs = append(pairs, [2][]byte{kb, vb})
+		return nil
+	})
+	if err != nil {
+		return dst, err
+	}
+	return appendJSONObject(dst, pairs), nil
+}
@@ -0,0 +1,1041 @@
+package rowbinary
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// https://clickhouse.com/docs/interfaces/formats/Native
+//
+// Native format is transcoded to and from RowBinary block by block: values of every column
+// are kept in RowBinary encoding, so all types read and write Native data with their RowBinary implementation.
+// Native layout differs from RowBinary for composite types only (null maps, array offsets,
+// element columns of tuples and LowCardinality dictionaries).
+
+// nativeBlockSize is number of rows buffered by FormatWriter before Native block is written
+const nativeBlockSize = 65536
+
+const (
+	lowCardinalitySharedDictionariesWithAdditionalKeys = 1
+
+	lowCardinalityIndexTypeMask        = 0xff
+	lowCardinalityNeedGlobalDictionary = 1 << 8
+	lowCardinalityHasAdditionalKeys    = 1 << 9
+	lowCardinalityNeedUpdateDictionary = 1 << 10
+)
+
+// nativeValues is a column of RowBinary encoded values
+type nativeValues struct {
+	buf  []byte
+	ends []int
+}
+
+func (v *nativeValues) len() int {
+	return len(v.ends)
+}
+
+func (v *nativeValues) start(i int) int {
+	if i == 0 {
+		return 0
+	}
+	return v.ends[i-1]
+}
+
+func (v *nativeValues) value(i int) []byte {
+	return v.buf[v.start(i):v.ends[i]]
+}
+
+// span returns values [from, to) as one slice
+func (v *nativeValues) span(from, to int) []byte {
+	if from == to {
+		return nil
+	}
+	return v.buf[v.start(from):v.ends[to-1]]
+}
+
+func (v *nativeValues) add(b []byte) {
+	v.buf = append(v.buf, b...)
+	v.end()
+}
+
+// end marks end of value appended to buf directly
+func (v *nativeValues) end() {
+	v.ends = append(v.ends, len(v.buf))
+}
+
+func (v *nativeValues) reset() {
+	v.buf = v.buf[:0]
+	v.ends = v.ends[:0]
+}
+
+// nativeCodec converts column of single type between RowBinary and Native encodings
+type nativeCodec interface {
+	// size returns length of RowBinary value at the beginning of b
+	size(b []byte) (int, error)
+	// zero returns RowBinary encoding of default value
+	zero() []byte
+	// writePrefix writes serialization state which precedes column data
+	writePrefix(w Writer) error
+	// readPrefix reads serialization state which precedes column data
+	readPrefix(r Reader) error
+	// encode writes RowBinary values as Native column
+	encode(w Writer, values *nativeValues) error
+	// decode reads Native column of n values and appends them to dst in RowBinary encoding
+	decode(r Reader, n int, dst *nativeValues) error
+}
+
+func newNativeCodec(tp Any) (nativeCodec, error) {
+	c, err := nativeCodecFrom(newSliceReader(tp.Binary()))
+	if err != nil {
+		return nil, fmt.Errorf("type %s is not supported by Native format: %w", tp.String(), err)
+	}
+	return c, nil
+}
+
+// nativeCodecFrom builds codec from binary type encoding
+func nativeCodecFrom(r *sliceReader) (nativeCodec, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch [1]byte{b} {
+	case BinaryTypeNothing:
+		return nativeNothing{}, nil
+	case BinaryTypeString:
+		return nativeString{}, nil
+	case BinaryTypeFixedString:
+		n, err := VarintRead(r)
+		if err != nil {
+			return nil, err
+		}
+		return nativeFixed{width: int(n)}, nil
+	case BinaryTypeNullable:
+		nested, err := nativeCodecFrom(r)
+		if err != nil {
+			return nil, err
+		}
+		return nativeNullable{nested: nested}, nil
+	case BinaryTypeArray:
+		nested, err := nativeCodecFrom(r)
+		if err != nil {
+			return nil, err
+		}
+		return nativeArray{nested: nested}, nil
+	case BinaryTypeLowCardinality:
+		nested, err := nativeCodecFrom(r)
+		if err != nil {
+			return nil, err
+		}
+		if nullable, ok := nested.(nativeNullable); ok {
+			return nativeLowCardinality{dict: nullable.nested, nullable: true}, nil
+		}
+		return nativeLowCardinality{dict: nested}, nil
+	case BinaryTypeMap:
+		key, err := nativeCodecFrom(r)
+		if err != nil {
+			return nil, err
+		}
+		value, err := nativeCodecFrom(r)
+		if err != nil {
+			return nil, err
+		}
+		return nativeMap{key: key, value: value}, nil
+	case BinaryTypeTuple, BinaryTypeTupleNamed:
+		n, err := VarintRead(r)
+		if err != nil {
+			return nil, err
+		}
+		elems := make([]nativeCodec, 0, n)
+		for range n {
+			if [1]byte{b} == BinaryTypeTupleNamed {
+				var name string
+				if err := String.Scan(r, &name); err != nil {
+					return nil, err
+				}
+			}
+			elem, err := nativeCodecFrom(r)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+		}
+		return nativeTuple{elems: elems}, nil
+	}
+
+	width, ok := nativeFixedWidth[[1]byte{b}]
+	if !ok {
+		return nil, NotImplementedError
+	}
+	// skip type parameters
+	if err := r.UnreadByte(); err != nil {
+		return nil, err
+	}
+	if _, err := DecodeBinaryType(r); err != nil {
+		return nil, err
+	}
+	return nativeFixed{width: width}, nil
+}
+
+// nativeFixedWidth contains types with the same fixed width encoding in RowBinary and Native
+var nativeFixedWidth = map[[1]byte]int{
+	BinaryTypeUInt8:                  1,
+	BinaryTypeUInt16:                 2,
+	BinaryTypeUInt32:                 4,
+	BinaryTypeUInt64:                 8,
+	BinaryTypeUInt128:                16,
+	BinaryTypeUInt256:                32,
+	BinaryTypeInt8:                   1,
+	BinaryTypeInt16:                  2,
+	BinaryTypeInt32:                  4,
+	BinaryTypeInt64:                  8,
+	BinaryTypeInt128:                 16,
+	BinaryTypeInt256:                 32,
+	BinaryTypeFloat32:                4,
+	BinaryTypeFloat64:                8,
+	BinaryTypeBFloat16:               2,
+	BinaryTypeDate:                   2,
+	BinaryTypeDate32:                 4,
+	BinaryTypeDateTime:               4,
+	BinaryTypeDateTimeWithTimeZone:   4,
+	BinaryTypeDateTime64:             8,
+	BinaryTypeDateTime64WithTimeZone: 8,
+	BinaryTypeTime:                   4,
+	BinaryTypeTime64:                 8,
+	BinaryTypeEnum8:                  1,
+	BinaryTypeEnum16:                 2,
+	BinaryTypeDecimal32:              4,
+	BinaryTypeDecimal64:              8,
+	BinaryTypeDecimal128:             16,
+	BinaryTypeDecimal256:             32,
+	BinaryTypeUUID:                   16,
+	BinaryTypeInterval:               8,
+	BinaryTypeIPv4:                   4,
+	BinaryTypeIPv6:                   16,
+	BinaryTypeBool:                   1,
+}
+
+// nativeNoPrefix is embedded by codecs without serialization state
+type nativeNoPrefix struct{}
+
+func (nativeNoPrefix) writePrefix(w Writer) error {
+	return nil
+}
+
+func (nativeNoPrefix) readPrefix(r Reader) error {
+	return nil
+}
+
+// nativeReadData appends n values of width bytes read from r to dst. Memory grows as data arrives,
+// so corrupt counts fail with io.ErrUnexpectedEOF instead of huge allocations
+func nativeReadData(r Reader, dst []byte, n, width int) ([]byte, error) {
+	if width == 0 || n == 0 {
+		return dst, nil
+	}
+	if n > math.MaxInt/width {
+		return dst, fmt.Errorf("too many values in Native column: %d", n)
+	}
+	return readAppend(dst, r, n*width)
+}
+
+// nativeReadOffsets reads cumulative offsets of n Array or Map values, lengths of values are checked against limits of r
+func nativeReadOffsets(r Reader, n int) ([]uint64, error) {
+	buf, err := nativeReadData(r, nil, n, 8)
+	if err != nil {
+		return nil, err
+	}
+	offsets := make([]uint64, n)
+	var prev uint64
+	for i := range offsets {
+		offsets[i] = binary.LittleEndian.Uint64(buf[i*8:])
+		if offsets[i] < prev {
+			return nil, fmt.Errorf("invalid offsets in Native column")
+		}
+		if _, err := checkCollectionLen(r, offsets[i]-prev); err != nil {
+			return nil, err
+		}
+		prev = offsets[i]
+	}
+	if prev > math.MaxInt {
+		return nil, fmt.Errorf("invalid offsets in Native column")
+	}
+	return offsets, nil
+}
+
+// nativeSplit moves first RowBinary value of b to dst and returns the rest of b
+func nativeSplit(c nativeCodec, b []byte, dst *nativeValues) ([]byte, error) {
+	n, err := c.size(b)
+	if err != nil {
+		return nil, err
+	}
+	dst.add(b[:n])
+	return b[n:], nil
+}
+
+type nativeFixed struct {
+	nativeNoPrefix
+	width int
+}
+
+func (c nativeFixed) size(b []byte) (int, error) {
+	if len(b) < c.width {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return c.width, nil
+}
+
+func (c nativeFixed) zero() []byte {
+	return make([]byte, c.width)
+}
+
+func (c nativeFixed) encode(w Writer, values *nativeValues) error {
+	_, err := w.Write(values.buf)
+	return err
+}
+
+func (c nativeFixed) decode(r Reader, n int, dst *nativeValues) error {
+	if l := limitsOf(r); l != nil && l.MaxRowBytes > 0 && int64(c.width) > l.MaxRowBytes {
+		return LimitError{Limit: "MaxRowBytes", Value: uint64(c.width), Max: l.MaxRowBytes}
+	}
+	start := len(dst.buf)
+	var err error
+	if dst.buf, err = nativeReadData(r, dst.buf, n, c.width); err != nil {
+		return err
+	}
+	for i := 1; i <= n; i++ {
+		dst.ends = append(dst.ends, start+i*c.width)
+	}
+	return nil
+}
+
+type nativeString struct {
+	nativeNoPrefix
+}
+
+func (c nativeString) size(b []byte) (int, error) {
+	l, k := binary.Uvarint(b)
+	if k <= 0 || uint64(len(b)-k) < l {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return k + int(l), nil
+}
+
+func (c nativeString) zero() []byte {
+	return []byte{0}
+}
+
+func (c nativeString) encode(w Writer, values *nativeValues) error {
+	_, err := w.Write(values.buf)
+	return err
+}
+
+func (c nativeString) decode(r Reader, n int, dst *nativeValues) error {
+	for range n {
+		l, err := readStringLen(r)
+		if err != nil {
+			return err
+		}
+		dst.buf = binary.AppendUvarint(dst.buf, uint64(l))
+		if dst.buf, err = readAppend(dst.buf, r, l); err != nil {
+			return err
+		}
+		dst.end()
+	}
+	return nil
+}
+
+// nativeNothing has empty RowBinary encoding and single byte per value in Native
+type nativeNothing struct {
+	nativeNoPrefix
+}
+
+func (c nativeNothing) size(b []byte) (int, error) {
+	return 0, nil
+}
+
+func (c nativeNothing) zero() []byte {
+	return nil
+}
+
+func (c nativeNothing) encode(w Writer, values *nativeValues) error {
+	for range values.len() {
+		if err := w.WriteByte('0'); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c nativeNothing) decode(r Reader, n int, dst *nativeValues) error {
+	if _, err := r.Discard(n); err != nil {
+		return err
+	}
+	for range n {
+		dst.end()
+	}
+	return nil
+}
+
+// nativeNullable is written as null map followed by nested column with default values in place of nulls
+type nativeNullable struct {
+	nested nativeCodec
+}
+
+func (c nativeNullable) size(b []byte) (int, error) {
+	if len(b) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if b[0] == 1 {
+		return 1, nil
+	}
+	n, err := c.nested.size(b[1:])
+	return n + 1, err
+}
+
+func (c nativeNullable) zero() []byte {
+	return []byte{1}
+}
+
+func (c nativeNullable) writePrefix(w Writer) error {
+	return c.nested.writePrefix(w)
+}
+
+func (c nativeNullable) readPrefix(r Reader) error {
+	return c.nested.readPrefix(r)
+}
+
+func (c nativeNullable) encode(w Writer, values *nativeValues) error {
+	nulls := make([]byte, values.len())
+	var nested nativeValues
+	for i := range nulls {
+		v := values.value(i)
+		if v[0] == 1 {
+			nulls[i] = 1
+			nested.add(c.nested.zero())
+		} else {
+			nested.add(v[1:])
+		}
+	}
+	if _, err := w.Write(nulls); err != nil {
+		return err
+	}
+	return c.nested.encode(w, &nested)
+}
+
+func (c nativeNullable) decode(r Reader, n int, dst *nativeValues) error {
+	nulls, err := nativeReadData(r, nil, n, 1)
+	if err != nil {
+		return err
+	}
+	var nested nativeValues
+	if err := c.nested.decode(r, n, &nested); err != nil {
+		return err
+	}
+	for i := range nulls {
+		if nulls[i] != 0 {
+			dst.buf = append(dst.buf, 1)
+		} else {
+			dst.buf = append(dst.buf, 0)
+			dst.buf = append(dst.buf, nested.value(i)...)
+		}
+		dst.end()
+	}
+	return nil
+}
+
+// nativeArray is written as cumulative UInt64 offsets followed by column of all elements
+type nativeArray struct {
+	nested nativeCodec
+}
+
+func (c nativeArray) size(b []byte) (int, error) {
+	l, k := binary.Uvarint(b)
+	if k <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	off := k
+	for range l {
+		n, err := c.nested.size(b[off:])
+		if err != nil {
+			return 0, err
+		}
+		off += n
+	}
+	return off, nil
+}
+
+func (c nativeArray) zero() []byte {
+	return []byte{0}
+}
+
+func (c nativeArray) writePrefix(w Writer) error {
+	return c.nested.writePrefix(w)
+}
+
+func (c nativeArray) readPrefix(r Reader) error {
+	return c.nested.readPrefix(r)
+}
+
+func (c nativeArray) encode(w Writer, values *nativeValues) error {
+	offsets := make([]byte, 0, values.len()*8)
+	var nested nativeValues
+	var total uint64
+	for i := range values.len() {
+		v := values.value(i)
+		l, k := binary.Uvarint(v)
+		v = v[k:]
+		for range l {
+			var err error
+			if v, err = nativeSplit(c.nested, v, &nested); err != nil {
+				return err
+			}
+		}
+		total += l
+		offsets = binary.LittleEndian.AppendUint64(offsets, total)
+	}
+	if _, err := w.Write(offsets); err != nil {
+		return err
+	}
+	return c.nested.encode(w, &nested)
+}
+
+func (c nativeArray) decode(r Reader, n int, dst *nativeValues) error {
+	offsets, err := nativeReadOffsets(r, n)
+	if err != nil {
+		return err
+	}
+	var nested nativeValues
+	if n > 0 {
+		if err := c.nested.decode(r, int(offsets[n-1]), &nested); err != nil {
+			return err
+		}
+	}
+	prev := 0
+	for _, off := range offsets {
+		dst.buf = binary.AppendUvarint(dst.buf, off-uint64(prev))
+		dst.buf = append(dst.buf, nested.span(prev, int(off))...)
+		dst.end()
+		prev = int(off)
+	}
+	return nil
+}
+
+// nativeMap is written as Array(Tuple(K, V)): offsets, column of keys and column of values
+type nativeMap struct {
+	key   nativeCodec
+	value nativeCodec
+}
+
+func (c nativeMap) size(b []byte) (int, error) {
+	l, k := binary.Uvarint(b)
+	if k <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	off := k
+	for range l {
+		n, err := c.key.size(b[off:])
+		if err != nil {
+			return 0, err
+		}
+		off += n
+		if n, err = c.value.size(b[off:]); err != nil {
+			return 0, err
+		}
+		off += n
+	}
+	return off, nil
+}
+
+func (c nativeMap) zero() []byte {
+	return []byte{0}
+}
+
+func (c nativeMap) writePrefix(w Writer) error {
+	if err := c.key.writePrefix(w); err != nil {
+		return err
+	}
+	return c.value.writePrefix(w)
+}
+
+func (c nativeMap) readPrefix(r Reader) error {
+	if err := c.key.readPrefix(r); err != nil {
+		return err
+	}
+	return c.value.readPrefix(r)
+}
+
+func (c nativeMap) encode(w Writer, values *nativeValues) error {
+	offsets := make([]byte, 0, values.len()*8)
+	var keys, vals nativeValues
+	var total uint64
+	for i := range values.len() {
+		v := values.value(i)
+		l, k := binary.Uvarint(v)
+		v = v[k:]
+		for range l {
+			var err error
+			if v, err = nativeSplit(c.key, v, &keys); err != nil {
+				return err
+			}
+			if v, err = nativeSplit(c.value, v, &vals); err != nil {
+				return err
+			}
+		}
+		total += l
+		offsets = binary.LittleEndian.AppendUint64(offsets, total)
+	}
+	if _, err := w.Write(offsets); err != nil {
+		return err
+	}
+	if err := c.key.encode(w, &keys); err != nil {
+		return err
+	}
+	return c.value.encode(w, &vals)
+}
+
+func (c nativeMap) decode(r Reader, n int, dst *nativeValues) error {
+	offsets, err := nativeReadOffsets(r, n)
+	if err != nil {
+		return err
+	}
+	var keys, vals nativeValues
+	if n > 0 {
+		if err := c.key.decode(r, int(offsets[n-1]), &keys); err != nil {
+			return err
+		}
+		if err := c.value.decode(r, int(offsets[n-1]), &vals); err != nil {
+			return err
+		}
+	}
+	prev := 0
+	for _, off := range offsets {
+		dst.buf = binary.AppendUvarint(dst.buf, off-uint64(prev))
+		for j := prev; j < int(off); j++ {
+			dst.buf = append(dst.buf, keys.value(j)...)
+			dst.buf = append(dst.buf, vals.value(j)...)
+		}
+		dst.end()
+		prev = int(off)
+	}
+	return nil
+}
+
+// nativeTuple is written as columns of elements one after another
+type nativeTuple struct {
+	elems []nativeCodec
+}
+
+func (c nativeTuple) size(b []byte) (int, error) {
+	off := 0
+	for _, e := range c.elems {
+		n, err := e.size(b[off:])
+		if err != nil {
+			return 0, err
+		}
+		off += n
+	}
+	return off, nil
+}
+
+func (c nativeTuple) zero() []byte {
+	var ret []byte
+	for _, e := range c.elems {
+		ret = append(ret, e.zero()...)
+	}
+	return ret
+}
+
+func (c nativeTuple) writePrefix(w Writer) error {
+	for _, e := range c.elems {
+		if err := e.writePrefix(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c nativeTuple) readPrefix(r Reader) error {
+	for _, e := range c.elems {
+		if err := e.readPrefix(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c nativeTuple) encode(w Writer, values *nativeValues) error {
+	elems := make([]nativeValues, len(c.elems))
+	for i := range values.len() {
+		v := values.value(i)
+		for j, e := range c.elems {
+			var err error
+			if v, err = nativeSplit(e, v, &elems[j]); err != nil {
+				return err
+			}
+		}
+	}
+	for j, e := range c.elems {
+		if err := e.encode(w, &elems[j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c nativeTuple) decode(r Reader, n int, dst *nativeValues) error {
+	elems := make([]nativeValues, len(c.elems))
+	for j, e := range c.elems {
+		if err := e.decode(r, n, &elems[j]); err != nil {
+			return err
+		}
+	}
+	for i := range n {
+		for j := range elems {
+			dst.buf = append(dst.buf, elems[j].value(i)...)
+		}
+		dst.end()
+	}
+	return nil
+}
+
+// nativeLowCardinality is written as dictionary of unique values followed by indexes.
+// For LowCardinality(Nullable(T)) dictionary has type T and index 0 means NULL.
+type nativeLowCardinality struct {
+	dict     nativeCodec
+	nullable bool
+}
+
+func (c nativeLowCardinality) size(b []byte) (int, error) {
+	if !c.nullable {
+		return c.dict.size(b)
+	}
+	return nativeNullable{nested: c.dict}.size(b)
+}
+
+func (c nativeLowCardinality) zero() []byte {
+	if c.nullable {
+		return []byte{1}
+	}
+	return c.dict.zero()
+}
+
+func (c nativeLowCardinality) writePrefix(w Writer) error {
+	return UInt64.Write(w, lowCardinalitySharedDictionariesWithAdditionalKeys)
+}
+
+func (c nativeLowCardinality) readPrefix(r Reader) error {
+	var version uint64
+	if err := UInt64.Scan(r, &version); err != nil {
+		return err
+	}
+	if version != lowCardinalitySharedDictionariesWithAdditionalKeys {
+		return fmt.Errorf("unsupported LowCardinality serialization version %d", version)
+	}
+	return nil
+}
+
+func (c nativeLowCardinality) encode(w Writer, values *nativeValues) error {
+	// nothing is written for empty column, e.g. for empty arrays
+	if values.len() == 0 {
+		return nil
+	}
+	var keys nativeValues
+	positions := make(map[string]uint64)
+	indexes := make([]uint64, values.len())
+	if c.nullable {
+		// NULL placeholder
+		keys.add(c.dict.zero())
+	}
+	for i := range indexes {
+		v := values.value(i)
+		if c.nullable {
+			if v[0] == 1 {
+				continue
+			}
+			v = v[1:]
+		}
+		pos, ok := positions[string(v)]
+		if !ok {
+			pos = uint64(keys.len())
+			positions[string(v)] = pos
+			keys.add(v)
+		}
+		indexes[i] = pos
+	}
+
+	indexType, width := nativeIndexType(keys.len())
+	if err := UInt64.Write(w, indexType|lowCardinalityHasAdditionalKeys|lowCardinalityNeedUpdateDictionary); err != nil {
+		return err
+	}
+	if err := UInt64.Write(w, uint64(keys.len())); err != nil {
+		return err
+	}
+	if err := c.dict.encode(w, &keys); err != nil {
+		return err
+	}
+	if err := UInt64.Write(w, uint64(len(indexes))); err != nil {
+		return err
+	}
+	buf := make([]byte, 0, len(indexes)*width)
+	var idxBuf [8]byte
+	for _, idx := range indexes {
+		binary.LittleEndian.PutUint64(idxBuf[:], idx)
+		buf = append(buf, idxBuf[:width]...)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func (c nativeLowCardinality) decode(r Reader, n int, dst *nativeValues) error {
+	var keys nativeValues
+	for n > 0 {
+		var flags uint64
+		if err := UInt64.Scan(r, &flags); err != nil {
+			return err
+		}
+		if flags&lowCardinalityNeedGlobalDictionary != 0 {
+			return fmt.Errorf("LowCardinality global dictionary is not supported")
+		}
+		if flags&lowCardinalityHasAdditionalKeys != 0 {
+			var numKeys uint64
+			if err := UInt64.Scan(r, &numKeys); err != nil {
+				return err
+			}
+			k, err := checkCollectionLen(r, numKeys)
+			if err != nil {
+				return err
+			}
+			keys.reset()
+			if err := c.dict.decode(r, k, &keys); err != nil {
+				return err
+			}
+		}
+		var numIndexes uint64
+		if err := UInt64.Scan(r, &numIndexes); err != nil {
+			return err
+		}
+		if numIndexes > uint64(n) {
+			return fmt.Errorf("too many LowCardinality indexes: %d, expected %d", numIndexes, n)
+		}
+		width := 1 << (flags & lowCardinalityIndexTypeMask)
+		if width > 8 {
+			return fmt.Errorf("invalid LowCardinality index type %d", flags&lowCardinalityIndexTypeMask)
+		}
+		buf, err := nativeReadData(r, nil, int(numIndexes), width)
+		if err != nil {
+			return err
+		}
+		var idxBuf [8]byte
+		for i := 0; i < int(numIndexes); i++ {
+			copy(idxBuf[:], buf[i*width:(i+1)*width])
+			idx := int(binary.LittleEndian.Uint64(idxBuf[:]))
+			if idx >= keys.len() {
+				return fmt.Errorf("LowCardinality index %d out of dictionary size %d", idx, keys.len())
+			}
+			if c.nullable {
+				if idx == 0 {
+					dst.buf = append(dst.buf, 1)
+					dst.end()
+					continue
+				}
+				dst.buf = append(dst.buf, 0)
+			}
+			dst.buf = append(dst.buf, keys.value(idx)...)
+			dst.end()
+		}
+		n -= int(numIndexes)
+	}
+	return nil
+}
+
+// nativeIndexType returns LowCardinality index type and its width for dictionary of n keys
+func nativeIndexType(n int) (uint64, int) {
+	switch {
+	case n <= 1<<8:
+		return 0, 1
+	case n <= 1<<16:
+		return 1, 2
+	case n <= 1<<32:
+		return 2, 4
+	default:
+		return 3, 8
+	}
+}
+
+// nativeReader reads Native blocks and transcodes them to RowBinary rows
+type nativeReader struct {
+	src    Reader
+	types  []string
+	codecs []nativeCodec
+	values []nativeValues
+	buf    []byte
+	rows   sliceReader
+}
+
+func newNativeReader(src Reader) *nativeReader {
+	return &nativeReader{src: src}
+}
+
+// readBlock reads next block and returns its columns. Returns io.EOF if there are no more blocks
+func (n *nativeReader) readBlock() ([]Column, error) {
+	numColumns, err := readCollectionLen(n.src)
+	if err != nil {
+		return nil, err
+	}
+	numRows, err := readCollectionLen(n.src)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	// columns are added as they are read, corrupt numColumns fails at the end of data
+	if len(n.types) != numColumns {
+		n.types = n.types[:0]
+		n.codecs = n.codecs[:0]
+		n.values = n.values[:0]
+	}
+
+	var columns []Column
+	for i := range numColumns {
+		if i == len(n.types) {
+			n.types = append(n.types, "")
+			n.codecs = append(n.codecs, nil)
+			n.values = append(n.values, nativeValues{})
+		}
+		var name, typeName string
+		if err := String.Scan(n.src, &name); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if err := String.Scan(n.src, &typeName); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		tp, err := DecodeStringType(typeName)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, Column{name: name, tp: tp})
+
+		if n.codecs[i] == nil || n.types[i] != typeName {
+			if n.codecs[i], err = newNativeCodec(tp); err != nil {
+				return nil, err
+			}
+			n.types[i] = typeName
+		}
+
+		n.values[i].reset()
+		// zero rows are represented as zero bytes of data
+		if numRows == 0 {
+			continue
+		}
+		if err := n.codecs[i].readPrefix(n.src); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if err := n.codecs[i].decode(n.src, numRows, &n.values[i]); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+	}
+
+	n.buf = n.buf[:0]
+	for row := range numRows {
+		for i := range n.values {
+			n.buf = append(n.buf, n.values[i].value(row)...)
+		}
+	}
+	n.rows.reset(n.buf)
+	return columns, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// nativeWriter collects RowBinary values of every column and writes them as Native blocks.
+// Values are written to the current column, next moves to the next one
+type nativeWriter struct {
+	dst     Writer
+	columns []Column
+	codecs  []nativeCodec
+	values  []nativeValues
+	current int
+	rows    int
+	buf     [16]byte
+}
+
+func newNativeWriter(dst Writer, columns []Column) (*nativeWriter, error) {
+	codecs := make([]nativeCodec, len(columns))
+	for i, col := range columns {
+		var err error
+		if codecs[i], err = newNativeCodec(col.tp); err != nil {
+			return nil, err
+		}
+	}
+	return &nativeWriter{
+		dst:     dst,
+		columns: columns,
+		codecs:  codecs,
+		values:  make([]nativeValues, len(columns)),
+	}, nil
+}
+
+func (n *nativeWriter) Write(p []byte) (int, error) {
+	v := &n.values[n.current]
+	v.buf = append(v.buf, p...)
+	return len(p), nil
+}
+
+func (n *nativeWriter) WriteByte(b byte) error {
+	v := &n.values[n.current]
+	v.buf = append(v.buf, b)
+	return nil
+}
+
+func (n *nativeWriter) Buffer() []byte {
+	return n.buf[:]
+}
+
+// next finishes value of the current column
+func (n *nativeWriter) next() {
+	n.values[n.current].end()
+	n.current++
+	if n.current == len(n.columns) {
+		n.current = 0
+		n.rows++
+	}
+}
+
+// flush writes collected rows as Native block
+func (n *nativeWriter) flush() error {
+	if n.current != 0 {
+		return fmt.Errorf("incomplete row: %d of %d columns written", n.current, len(n.columns))
+	}
+	if n.rows == 0 {
+		return nil
+	}
+	if err := VarintWrite(n.dst, uint64(len(n.columns))); err != nil {
+		return err
+	}
+	if err := VarintWrite(n.dst, uint64(n.rows)); err != nil {
+		return err
+	}
+	for i, col := range n.columns {
+		if err := String.Write(n.dst, col.name); err != nil {
+			return err
+		}
+		if err := String.Write(n.dst, col.tp.String()); err != nil {
+			return err
+		}
+		if err := n.codecs[i].writePrefix(n.dst); err != nil {
+			return err
+		}
+		if err := n.codecs[i].encode(n.dst, &n.values[i]); err != nil {
+			return err
+		}
+		n.values[i].reset()
+	}
+	n.rows = 0
+	return nil
+}
@@ -0,0 +1,121 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNative_Encoding(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, Native, C("a", UInt8), C("b", Nullable(String)), C("c", Array(UInt16)), C("d", LowCardinality(String)))
+	assert.NoError(w.WriteAny(uint8(1), (*string)(nil), []uint16{1, 2}, "a"))
+	assert.NoError(w.WriteAny(uint8(2), pointer("x"), []uint16{}, "b"))
+	assert.NoError(w.WriteAny(uint8(3), pointer(""), []uint16{3}, "a"))
+	assert.NoError(w.Flush())
+
+	expected := []byte{
+		4, 3, // columns, rows
+		1, 'a', 5, 'U', 'I', 'n', 't', '8',
+		1, 2, 3,
+		1, 'b', 16, 'N', 'u', 'l', 'l', 'a', 'b', 'l', 'e', '(', 'S', 't', 'r', 'i', 'n', 'g', ')',
+		1, 0, 0, // null map
+		0, 1, 'x', 0,
+		1, 'c', 13, 'A', 'r', 'r', 'a', 'y', '(', 'U', 'I', 'n', 't', '1', '6', ')',
+		2, 0, 0, 0, 0, 0, 0, 0, // offsets
+		2, 0, 0, 0, 0, 0, 0, 0,
+		3, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 2, 0, 3, 0,
+		1, 'd', 22, 'L', 'o', 'w', 'C', 'a', 'r', 'd', 'i', 'n', 'a', 'l', 'i', 't', 'y', '(', 'S', 't', 'r', 'i', 'n', 'g', ')',
+		1, 0, 0, 0, 0, 0, 0, 0, // serialization version
+		0, 6, 0, 0, 0, 0, 0, 0, // UInt8 indexes with additional keys
+		2, 0, 0, 0, 0, 0, 0, 0, // dictionary
+		1, 'a', 1, 'b',
+		3, 0, 0, 0, 0, 0, 0, 0, // indexes
+		0, 1, 0,
+	}
+	assert.Equal(expected, buf.Bytes())
+
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), Native, C("b", Nullable(String)), C("c", Array(UInt16)), C("d", LowCardinality(String)))
+	var rows [][]any
+	for r.Next() {
+		var a uint8
+		var b *string
+		var c []uint16
+		var d string
+		assert.NoError(r.Scan(&a, &b, &c, &d))
+		rows = append(rows, []any{a, b, c, d})
+	}
+	assert.NoError(r.Err())
+	assert.Equal([][]any{
+		{uint8(1), (*string)(nil), []uint16{1, 2}, "a"},
+		{uint8(2), pointer("x"), []uint16{}, "b"},
+		{uint8(3), pointer(""), []uint16{3}, "a"},
+	}, rows)
+}
+
+func TestNative_RoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	columns := []Column{
+		C("m", Map(String, Array(Nullable(UInt32)))),
+		C("t", TupleNamedAny(C("i", Int64), C("s", LowCardinality(Nullable(String))))),
+		C("a", Array(LowCardinality(String))),
+		C("f", FixedString(2)),
+		C("n", Nullable(Nothing)),
+	}
+	values := [][]any{
+		{map[string][]*uint32{"a": {nil, pointer(uint32(1))}}, []any{int64(-1), pointer("x")}, []string{"x", "y", "x"}, []byte("ab"), (*any)(nil)},
+		{map[string][]*uint32{}, []any{int64(2), (*string)(nil)}, []string{}, []byte("cd"), (*any)(nil)},
+	}
+
+	var buf bytes.Buffer
+	opts := []FormatOption{Native}
+	for _, col := range columns {
+		opts = append(opts, col)
+	}
+	w := NewFormatWriter(&buf, opts...)
+	for i, row := range values {
+		assert.NoError(w.WriteAny(row...))
+		// every row in separate block
+		assert.NoError(w.Flush(), i)
+	}
+
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), Native)
+	var scanned [][]any
+	for r.Next() {
+		row := make([]any, len(columns))
+		ptrs := make([]any, len(columns))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		assert.NoError(r.Scan(ptrs...))
+		scanned = append(scanned, row)
+	}
+	assert.NoError(r.Err())
+	assert.Equal(len(values), len(scanned))
+
+	cols, err := r.Columns()
+	assert.NoError(err)
+	for i, col := range columns {
+		assert.Equal(col.Name(), cols.cols[i].Name())
+		assert.True(Eq(col.Type(), cols.cols[i].Type()), col.Type().String())
+	}
+	assert.Equal([]any{"x", "y", "x"}, scanned[0][2])
+	assert.Equal(map[any]any{"a": []any{(*any)(nil), pointer(any(uint32(1)))}}, scanned[0][0])
+	assert.Equal([]any{int64(2), (*any)(nil)}, scanned[1][1])
+	assert.Equal([]byte("cd"), scanned[1][3])
+}
+
+func TestNative_Unsupported(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, Native, C("d", Dynamic(0)))
+	assert.ErrorIs(t, w.WriteAny(Value{UInt8, uint8(1)}), NotImplementedError)
+
+	w = NewFormatWriter(&buf, Native, C("a", UInt8), C("b", UInt8))
+	assert.NoError(t, w.WriteAny(uint8(1)))
+	assert.ErrorContains(t, w.Flush(), "incomplete row")
+}
@@ -16,6 +16,10 @@ func (t typeNothing) Write(w Writer, value any) error {
 	return nil
 }
 
+func (t typeNothing) Append(dst []byte, v any) ([]byte, error) {
+	return dst, nil
+}
+
 func (t typeNothing) Read(r Reader) (any, error) {
 	return nil, nil
 }
@@ -24,3 +28,23 @@ func (t typeNothing) Scan(r Reader, v *any) (err error) {
 	*v, err = t.Read(r)
 	return
 }
+
+func (t typeNothing) Skip(r Reader) error {
+	return nil
+}
+
+func (t typeNothing) AppendLiteral(dst []byte, v any) ([]byte, error) {
+	return append(dst, "NULL"...), nil
+}
+
+func (t typeNothing) ScanLiteral(s *LiteralScanner, v *any) error {
+	if !s.ConsumeKeyword("NULL") {
+		return s.Errorf("expected NULL")
+	}
+	*v = nil
+	return nil
+}
+
+func (t typeNothing) AppendJSON(dst []byte, v any, opts *JSONOptions) ([]byte, error) {
+	return append(dst, "null"...), nil
+}
@@ -47,6 +47,13 @@ func (t typeNullable[V]) Write(w Writer, value *V) error {
 	return t.valueType.Write(w, *value)
 }
 
+func (t typeNullable[V]) Append(dst []byte, v *V) ([]byte, error) {
+	if v == nil {
+		return append(dst, 0x01), nil
+	}
+	return Append(append(dst, 0x00), t.valueType, *v)
+}
+
 func (t typeNullable[V]) Scan(r Reader, v **V) error {
 	b, err := r.ReadByte()
 	if err != nil {
@@ -67,3 +74,58 @@ func (t typeNullable[V]) Scan(r Reader, v **V) error {
 	*v = &x
 	return nil
 }
+
+func (t typeNullable[V]) Skip(r Reader) error {
+	b, err := r.ReadByte()
+	if err != nil || b == 0x01 {
+		return err
+	}
+	return Skip(r, t.valueType)
+}
+
+func (t typeNullable[V]) coerce(v any) (*V, error) {
+	if value, ok := v.(*V); ok {
+		return value, nil
+	}
+	if coerceIsNil(v) {
+		return nil, nil
+	}
+	x, err := Coerce(t.valueType, v)
+	if err != nil {
+		return nil, err
+	}
+	return &x, nil
+}
+
+func (t typeNullable[V]) AppendLiteral(dst []byte, value *V) ([]byte, error) {
+	if value == nil {
+		return append(dst, "NULL"...), nil
+	}
+	return AppendLiteral(dst, t.valueType, *value)
+}
+
+func (t typeNullable[V]) ScanLiteral(s *LiteralScanner, v **V) error {
+	if s.ConsumeKeyword("NULL") {
+		*v = nil
+		return nil
+	}
+	var x V
+	if err := scanLiteral(s, t.valueType, &x); err != nil {
+		return err
+	}
+	*v = &x
+	return nil
+}
+
+func (t typeNullable[V]) AppendJSON(dst []byte, value *V, opts *JSONOptions) ([]byte, error) {
+	if value == nil {
+		return append(dst, "null"...), nil
+	}
+	return AppendJSON(dst, t.valueType, *value, opts)
+}
+
+// isNullable reports whether values of tp are nullable, LowCardinality(Nullable(T)) included
+func isNullable(tp Any) bool {
+	bin := stripLowCardinality(tp.Binary())
+	return len(bin) > 0 && [1]byte{bin[0]} == BinaryTypeNullable
+}
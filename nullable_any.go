@@ -49,6 +49,13 @@ func (t typeNullableAny) Write(w Writer, value *any) error {
 	return err
 }
 
+func (t typeNullableAny) Append(dst []byte, v *any) ([]byte, error) {
+	if v == nil {
+		return append(dst, 0x01), nil
+	}
+	return appendAny(append(dst, 0x00), t.valueType, *v)
+}
+
 func (t typeNullableAny) Scan(r Reader, v **any) (err error) {
 	b, err := r.ReadByte()
 	if err != nil {
@@ -68,3 +75,49 @@ func (t typeNullableAny) Scan(r Reader, v **any) (err error) {
 	*v = &x
 	return nil
 }
+
+func (t typeNullableAny) Skip(r Reader) error {
+	b, err := r.ReadByte()
+	if err != nil || b == 0x01 {
+		return err
+	}
+	return Skip(r, t.valueType)
+}
+
+func (t typeNullableAny) coerce(v any) (*any, error) {
+	if coerceIsNil(v) {
+		return nil, nil
+	}
+	x, err := coerceAny(t.valueType, v)
+	if err != nil {
+		return nil, err
+	}
+	return &x, nil
+}
+
+func (t typeNullableAny) AppendLiteral(dst []byte, value *any) ([]byte, error) {
+	if value == nil {
+		return append(dst, "NULL"...), nil
+	}
+	return appendLiteralAny(dst, t.valueType, *value)
+}
+
+func (t typeNullableAny) ScanLiteral(s *LiteralScanner, v **any) error {
+	if s.ConsumeKeyword("NULL") {
+		*v = nil
+		return nil
+	}
+	x, err := scanLiteralAny(s, t.valueType)
+	if err != nil {
+		return err
+	}
+	*v = &x
+	return nil
+}
+
+func (t typeNullableAny) AppendJSON(dst []byte, value *any, opts *JSONOptions) ([]byte, error) {
+	if value == nil {
+		return append(dst, "null"...), nil
+	}
+	return appendJSONAny(dst, t.valueType, *value, opts)
+}
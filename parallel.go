@@ -0,0 +1,166 @@
+package rowbinary
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// ParallelConfig configures ParallelRows.
+type ParallelConfig struct {
+	// Workers is the number of goroutines calling process, runtime.GOMAXPROCS(0) if zero
+	Workers int
+	// QueueSize is the number of decoded rows waiting for workers, 2*Workers if zero.
+	// Decoding is paused while QueueSize+Workers rows are not delivered yet
+	QueueSize int
+	// Ordered makes deliver receive results in order of rows, otherwise results are delivered as soon as they are ready
+	Ordered bool
+}
+
+type parallelJob struct {
+	seq int64
+	row *Row
+}
+
+type parallelResult[T any] struct {
+	seq   int64
+	value T
+}
+
+// ParallelRows decodes rows of r on one goroutine and calls process for them on a pool of workers.
+// Results of process are passed to deliver on the calling goroutine, so deliver doesn't need synchronization.
+// Row passed to process is reused after process returns, result must not reference it. Deliver may be nil.
+//
+// The first error of decoding, process or deliver cancels ctx passed to process, stops decoding
+// (aborting HTTP body read in Client.Select) and is returned. Cancellation of ctx stops processing as well.
+func ParallelRows[T any](ctx context.Context, r *FormatReader, cfg ParallelConfig, process func(ctx context.Context, row *Row) (T, error), deliver func(T) error) error {
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = 2 * workers
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var errOnce sync.Once
+	var firstErr error
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+			if r.abort != nil {
+				r.abort()
+			}
+		})
+	}
+
+	// tokens of rows decoded but not delivered yet
+	inflight := make(chan struct{}, queueSize+workers)
+	free := make(chan *Row, queueSize+workers)
+	jobs := make(chan parallelJob, queueSize)
+	results := make(chan parallelResult[T], queueSize+workers)
+
+	var decoder sync.WaitGroup
+	decoder.Add(1)
+	go func() {
+		defer decoder.Done()
+		defer close(jobs)
+		for seq := int64(0); ; seq++ {
+			select {
+			case inflight <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			if !r.Next() {
+				if err := r.Err(); err != nil {
+					fail(err)
+				}
+				return
+			}
+			var row *Row
+			select {
+			case row = <-free:
+			default:
+				row = NewRow()
+			}
+			if err := r.ReadRow(row); err != nil {
+				fail(err)
+				return
+			}
+			select {
+			case jobs <- parallelJob{seq: seq, row: row}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var pool sync.WaitGroup
+	for range workers {
+		pool.Add(1)
+		go func() {
+			defer pool.Done()
+			for job := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				v, err := process(ctx, job.row)
+				free <- job.row
+				if err != nil {
+					fail(err)
+					continue
+				}
+				results <- parallelResult[T]{seq: job.seq, value: v}
+			}
+		}()
+	}
+	go func() {
+		pool.Wait()
+		close(results)
+	}()
+
+	send := func(v T) {
+		if deliver != nil && ctx.Err() == nil {
+			if err := deliver(v); err != nil {
+				fail(err)
+			}
+		}
+		<-inflight
+	}
+
+	next := int64(0)
+	pending := make(map[int64]T)
+	for res := range results {
+		if !cfg.Ordered {
+			send(res.value)
+			continue
+		}
+		pending[res.seq] = res.value
+		for {
+			v, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			send(v)
+		}
+	}
+	decoder.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// WithParallelRows processes rows of Select with ParallelRows, see ParallelConfig.
+func WithParallelRows[T any](cfg ParallelConfig, process func(ctx context.Context, row *Row) (T, error), deliver func(T) error) SelectOption {
+	return formatReaderOption{cb: func(ctx context.Context, r *FormatReader) error {
+		return ParallelRows(ctx, r, cfg, process, deliver)
+	}}
+}
@@ -0,0 +1,177 @@
+package rowbinary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testParallelData(t *testing.T, rows int) []byte {
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, C("n", UInt64))
+	for i := range rows {
+		assert.NoError(t, w.WriteRow(uint64(i)))
+	}
+	assert.NoError(t, w.Finish())
+	return buf.Bytes()
+}
+
+func TestParallelRows(t *testing.T) {
+	data := testParallelData(t, 10000)
+	ctx := context.Background()
+
+	double := func(ctx context.Context, row *Row) (uint64, error) {
+		n, err := Get[uint64](row, "n")
+		// uneven processing time
+		if n%97 == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		return n * 2, err
+	}
+
+	for _, ordered := range []bool{true, false} {
+		assert := assert.New(t)
+		cfg := ParallelConfig{Workers: 8, QueueSize: 4, Ordered: ordered}
+
+		var started, delivered atomic.Int64
+		var maxInflight int64
+		var values []uint64
+		r := NewFormatReaderBytes(data, C("n", UInt64))
+		err := ParallelRows(ctx, r, cfg, func(ctx context.Context, row *Row) (uint64, error) {
+			started.Add(1)
+			return double(ctx, row)
+		}, func(v uint64) error {
+			maxInflight = max(maxInflight, started.Load()-delivered.Load())
+			delivered.Add(1)
+			values = append(values, v)
+			return nil
+		})
+		assert.NoError(err)
+		assert.Len(values, 10000)
+		assert.LessOrEqual(maxInflight, int64(cfg.QueueSize+cfg.Workers))
+
+		if ordered {
+			assert.True(slices.IsSorted(values))
+		} else {
+			slices.Sort(values)
+		}
+		assert.Equal(uint64(0), values[0])
+		assert.Equal(uint64(19998), values[9999])
+	}
+
+	// defaults and nil deliver
+	var sum atomic.Uint64
+	err := ParallelRows(ctx, NewFormatReaderBytes(data, C("n", UInt64)), ParallelConfig{}, func(ctx context.Context, row *Row) (struct{}, error) {
+		n, err := Get[uint64](row, "n")
+		sum.Add(n)
+		return struct{}{}, err
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9999*10000/2), sum.Load())
+}
+
+func TestParallelRows_Errors(t *testing.T) {
+	data := testParallelData(t, 10000)
+	ctx := context.Background()
+	errProcess := errors.New("process")
+	errDeliver := errors.New("deliver")
+	cfg := ParallelConfig{Workers: 4, Ordered: true}
+
+	// process error stops decoding and calls abort
+	var processed atomic.Int64
+	aborted := 0
+	r := NewFormatReaderBytes(data, C("n", UInt64))
+	r.abort = func() { aborted++ }
+	err := ParallelRows(ctx, r, cfg, func(ctx context.Context, row *Row) (int, error) {
+		processed.Add(1)
+		if n, _ := Get[uint64](row, "n"); n == 100 {
+			return 0, errProcess
+		}
+		return 0, nil
+	}, nil)
+	assert.ErrorIs(t, err, errProcess)
+	assert.Equal(t, 1, aborted)
+	assert.Less(t, processed.Load(), int64(1000))
+
+	// deliver error
+	delivered := 0
+	err = ParallelRows(ctx, NewFormatReaderBytes(data, C("n", UInt64)), cfg, func(ctx context.Context, row *Row) (int, error) {
+		return 0, nil
+	}, func(int) error {
+		delivered++
+		if delivered == 10 {
+			return errDeliver
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errDeliver)
+	assert.Equal(t, 10, delivered)
+
+	// decoding error
+	err = ParallelRows(ctx, NewFormatReaderBytes(data[:len(data)-3], C("n", UInt64)), cfg, func(ctx context.Context, row *Row) (int, error) {
+		return 0, nil
+	}, nil)
+	var pe PositionError
+	assert.ErrorAs(t, err, &pe)
+	assert.Equal(t, int64(9999), pe.Row)
+
+	// cancellation
+	cctx, cancel := context.WithCancel(ctx)
+	delivered = 0
+	err = ParallelRows(cctx, NewFormatReaderBytes(data, C("n", UInt64)), cfg, func(ctx context.Context, row *Row) (int, error) {
+		return 0, nil
+	}, func(int) error {
+		delivered++
+		if delivered == 10 {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 10, delivered)
+}
+
+func TestParallelRows_Client(t *testing.T) {
+	row := testParallelData(t, 1)
+
+	// endless response
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte{1, 1, 'n', 6, 'U', 'I', 'n', 't', '6', '4'})
+		for req.Context().Err() == nil {
+			if _, err := rw.Write(bytes.Repeat(row, 1024)); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := NewClient(ctx, WithDSN(srv.URL))
+	errStop := errors.New("stop")
+
+	done := make(chan error, 1)
+	go func() {
+		var processed atomic.Int64
+		done <- c.Select(ctx, "SELECT n", WithParallelRows(ParallelConfig{Workers: 4}, func(ctx context.Context, row *Row) (int, error) {
+			if processed.Add(1) == 1000 {
+				return 0, errStop
+			}
+			return 0, nil
+		}, nil))
+	}()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, errStop)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Select is not stopped")
+	}
+}
@@ -0,0 +1,74 @@
+package rowbinary
+
+import (
+	"bufio"
+	"io"
+	"sync"
+)
+
+// DefaultWriteBufferSize is the size of buffer used by Client.Insert to write the request body
+const DefaultWriteBufferSize = 1024 * 1024
+
+// bufferPool reuses read and write buffers of client requests. Nil pool allocates new buffers every time
+type bufferPool struct {
+	readers sync.Map // buffer size -> *sync.Pool of *bufio.Reader
+	writers sync.Map // buffer size -> *sync.Pool of *bufio.Writer
+}
+
+func newBufferPool() *bufferPool {
+	return &bufferPool{}
+}
+
+func (p *bufferPool) pool(m *sync.Map, size int) *sync.Pool {
+	if v, ok := m.Load(size); ok {
+		return v.(*sync.Pool)
+	}
+	v, _ := m.LoadOrStore(size, &sync.Pool{})
+	return v.(*sync.Pool)
+}
+
+// getReader returns buffered reader of r with buffer of size bytes
+func (p *bufferPool) getReader(r io.Reader, size int) *bufio.Reader {
+	if size <= 0 {
+		size = DefaultReadBufferSize
+	}
+	if p != nil {
+		if br, ok := p.pool(&p.readers, size).Get().(*bufio.Reader); ok {
+			br.Reset(r)
+			return br
+		}
+	}
+	return bufio.NewReaderSize(r, size)
+}
+
+// putReader returns reader to pool, it must not be used after that
+func (p *bufferPool) putReader(br *bufio.Reader) {
+	if p == nil {
+		return
+	}
+	br.Reset(nil)
+	p.pool(&p.readers, br.Size()).Put(br)
+}
+
+// getWriter returns buffered writer to w with buffer of size bytes
+func (p *bufferPool) getWriter(w io.Writer, size int) *bufio.Writer {
+	if size <= 0 {
+		size = DefaultWriteBufferSize
+	}
+	if p != nil {
+		if bw, ok := p.pool(&p.writers, size).Get().(*bufio.Writer); ok {
+			bw.Reset(w)
+			return bw
+		}
+	}
+	return bufio.NewWriterSize(w, size)
+}
+
+// putWriter returns writer to pool, it must not be used after that
+func (p *bufferPool) putWriter(bw *bufio.Writer) {
+	if p == nil {
+		return
+	}
+	bw.Reset(nil)
+	p.pool(&p.writers, bw.Size()).Put(bw)
+}
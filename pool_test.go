@@ -0,0 +1,181 @@
+package rowbinary
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_Reset(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, C("a", UInt64), C("b", String))
+	for i := range 10 {
+		assert.NoError(w.WriteRow(uint64(i), "value"))
+	}
+	assert.NoError(w.Finish())
+	data := buf.Bytes()
+
+	src := bytes.NewReader(data)
+	r := NewFormatReader(src, C("a", UInt64), C("b", String))
+	var a uint64
+	var b []byte
+	read := func() {
+		src.Reset(data)
+		r.Reset(src)
+		for r.Next() {
+			if err := Scan(r, UInt64, &a); err != nil {
+				t.Fatal(err)
+			}
+			if err := Scan(r, StringBytes, &b); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := r.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read()
+	assert.Equal(uint64(9), a)
+	assert.Equal(int64(10), r.Rows())
+	assert.Equal(0.0, testing.AllocsPerRun(10, read))
+}
+
+func TestFormatWriter_Reset(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, C("a", UInt64), C("b", String))
+	write := func() {
+		buf.Reset()
+		w.Reset(&buf)
+		for i := range 10 {
+			if err := Write(w, UInt64, uint64(i)); err != nil {
+				t.Fatal(err)
+			}
+			if err := Write(w, String, "value"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Finish(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write()
+	expected := bytes.Clone(buf.Bytes())
+	assert.Equal(int64(10), w.Rows())
+
+	// partial row and error are discarded by Reset
+	assert.NoError(w.WriteAny(uint64(1)))
+	assert.Error(w.WriteAny(42))
+	write()
+	assert.Equal(expected, buf.Bytes())
+
+	assert.Equal(0.0, testing.AllocsPerRun(10, write))
+}
+
+func TestBufferPool(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, p := range []*bufferPool{nil, newBufferPool()} {
+		br := p.getReader(bytes.NewReader([]byte("abc")), 0)
+		assert.Equal(DefaultReadBufferSize, br.Size())
+		b, err := io.ReadAll(br)
+		assert.NoError(err)
+		assert.Equal("abc", string(b))
+		p.putReader(br)
+
+		br = p.getReader(bytes.NewReader([]byte("def")), 64)
+		assert.Equal(64, br.Size())
+		b, err = io.ReadAll(br)
+		assert.NoError(err)
+		assert.Equal("def", string(b))
+		p.putReader(br)
+
+		var out bytes.Buffer
+		bw := p.getWriter(&out, 0)
+		assert.Equal(DefaultWriteBufferSize, bw.Size())
+		_, err = bw.WriteString("xyz")
+		assert.NoError(err)
+		assert.NoError(bw.Flush())
+		p.putWriter(bw)
+		assert.Equal("xyz", out.String())
+
+		bw = p.getWriter(&out, 128)
+		assert.Equal(128, bw.Size())
+		assert.Equal(0, bw.Buffered())
+		p.putWriter(bw)
+	}
+}
+
+func TestBufferPooling_Client(t *testing.T) {
+	var rows bytes.Buffer
+	w := NewFormatWriter(&rows, RowBinaryWithNamesAndTypes, C("n", UInt64))
+	for i := range 1000 {
+		assert.NoError(t, w.WriteRow(uint64(i)))
+	}
+	assert.NoError(t, w.Finish())
+
+	var inserted [][]byte
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if req.URL.Query().Get("query") != "" {
+			inserted = append(inserted, body)
+			return
+		}
+		_, _ = rw.Write(rows.Bytes())
+	}))
+	defer srv.Close()
+
+	for _, opts := range [][]ClientOption{
+		{WithDSN(srv.URL)},
+		{WithDSN(srv.URL), WithBufferPooling(false)},
+		{WithDSN(srv.URL), WithReadBufferSize(16), WithWriteBufferSize(16)},
+	} {
+		assert := assert.New(t)
+		ctx := context.Background()
+		c := NewClient(ctx, opts...)
+		inserted = nil
+
+		for range 3 {
+			var sum uint64
+			assert.NoError(c.Select(ctx, "SELECT n", C("n", UInt64), WithFormatReader(func(r *FormatReader) error {
+				var n uint64
+				for r.Next() {
+					if err := Scan(r, UInt64, &n); err != nil {
+						return err
+					}
+					sum += n
+				}
+				return r.Err()
+			})))
+			assert.Equal(uint64(999*1000/2), sum)
+
+			assert.NoError(c.Insert(ctx, "t", RowBinary, C("n", UInt64), WithFormatWriter(func(w *FormatWriter) error {
+				for i := range 100 {
+					if err := w.WriteRow(uint64(i)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})))
+		}
+
+		assert.Len(inserted, 3)
+		for _, b := range inserted {
+			assert.Len(b, 800)
+		}
+	}
+}
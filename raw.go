@@ -0,0 +1,195 @@
+package rowbinary
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// rawChunkSize is the size of data copied at once by rawReader.Discard
+const rawChunkSize = 4096
+
+// rawReader appends bytes consumed from the wrapped Reader to dst
+type rawReader struct {
+	Reader
+	dst []byte
+}
+
+func (r *rawReader) readLimits() *Limits {
+	return limitsOf(r.Reader)
+}
+
+func (r *rawReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.dst = append(r.dst, p[:n]...)
+	return n, err
+}
+
+func (r *rawReader) ReadByte() (byte, error) {
+	b, err := r.Reader.ReadByte()
+	if err == nil {
+		r.dst = append(r.dst, b)
+	}
+	return b, err
+}
+
+func (r *rawReader) UnreadByte() error {
+	err := r.Reader.UnreadByte()
+	if err == nil {
+		r.dst = r.dst[:len(r.dst)-1]
+	}
+	return err
+}
+
+func (r *rawReader) Discard(n int) (int, error) {
+	discarded := 0
+	for discarded < n {
+		b, err := r.Reader.Peek(min(n-discarded, rawChunkSize))
+		if len(b) == 0 {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return discarded, err
+		}
+		r.dst = append(r.dst, b...)
+		d, err := r.Reader.Discard(len(b))
+		discarded += d
+		if err != nil {
+			return discarded, err
+		}
+	}
+	return discarded, nil
+}
+
+// ReadRawColumn appends encoded RowBinary value of the current column to dst without decoding it.
+// Value is found with type-aware length scanning (see Skip) and may be written with FormatWriter.WriteRawColumn.
+func (r *FormatReader) ReadRawColumn(dst []byte) ([]byte, error) {
+	if err := r.check(); err != nil {
+		return dst, err
+	}
+	if err := r.skipUnprojected(); err != nil {
+		return dst, err
+	}
+	dst, err := r.readRaw(dst, r.index)
+	if err != nil {
+		return dst, err
+	}
+	r.nextColumn()
+	return dst, r.skipTrailing()
+}
+
+// ReadRawRow appends encoded RowBinary values of remaining columns of the current row to dst,
+// the whole row if none of its values is read yet. Columns excluded by WithProjection are included,
+// so the row may be written with FormatWriter.WriteRawRow with the same columns.
+func (r *FormatReader) ReadRawRow(dst []byte) ([]byte, error) {
+	if err := r.check(); err != nil {
+		return dst, err
+	}
+	for {
+		var err error
+		if dst, err = r.readRaw(dst, r.index); err != nil {
+			return dst, err
+		}
+		r.nextColumn()
+		if r.index == 0 {
+			return dst, nil
+		}
+	}
+}
+
+// readRaw appends encoded value of column i to dst
+func (r *FormatReader) readRaw(dst []byte, i int) ([]byte, error) {
+	off := r.offset()
+	raw := rawReader{Reader: r.wrap, dst: dst}
+	if err := Skip(&raw, r.columns[i].tp); err != nil {
+		return raw.dst, r.valueErr(i, off, err)
+	}
+	return raw.dst, nil
+}
+
+// WriteRawColumn writes encoded RowBinary value of the current column, e.g. returned by FormatReader.ReadRawColumn.
+// Value is copied verbatim without validation.
+func (w *FormatWriter) WriteRawColumn(b []byte) error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	off := w.offset()
+	if err := w.writeValuePrefix(); err != nil {
+		return err
+	}
+	if _, err := w.wrap.Write(b); err != nil {
+		return w.valueErr(w.index, off, err)
+	}
+	w.nextColumn()
+	return nil
+}
+
+// WriteRawRow writes encoded RowBinary values of all columns, e.g. returned by FormatReader.ReadRawRow.
+// Row is copied verbatim to RowBinary formats. Native and RowBinaryWithDefaults formats
+// need boundaries of values, they are found with type-aware length scanning.
+func (w *FormatWriter) WriteRawRow(b []byte) error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if w.index != 0 {
+		return w.setErr(errors.New("raw row must start at the beginning of row"))
+	}
+
+	if w.native == nil && w.options.format != RowBinaryWithDefaults {
+		off := w.offset()
+		if _, err := w.wrap.Write(b); err != nil {
+			return w.valueErr(0, off, err)
+		}
+		w.rows++
+		return nil
+	}
+
+	sr := newSliceReader(b)
+	for i, col := range w.options.columns {
+		start := sr.off
+		if err := Skip(sr, col.tp); err != nil {
+			return w.valueErr(i, w.offset(), err)
+		}
+		if err := w.WriteRawColumn(b[start:sr.off]); err != nil {
+			return err
+		}
+	}
+	if sr.Len() != 0 {
+		return w.setErr(fmt.Errorf("raw row has %d extra bytes", sr.Len()))
+	}
+	return nil
+}
+
+// writeRawRows writes n rows encoded in format, e.g. by InsertProducer. Format is RowBinary
+// or RowBinaryWithDefaults, rows of RowBinaryWithDefaults are copied verbatim to the same format.
+func (w *FormatWriter) writeRawRows(b []byte, n int, format Format) error {
+	if err := w.check(); err != nil {
+		return err
+	}
+	if w.index != 0 {
+		return w.setErr(errors.New("raw rows must start at the beginning of row"))
+	}
+
+	if w.native == nil && (w.options.format != RowBinaryWithDefaults || format == RowBinaryWithDefaults) {
+		off := w.offset()
+		if _, err := w.wrap.Write(b); err != nil {
+			return w.valueErr(0, off, err)
+		}
+		w.rows += int64(n)
+		return nil
+	}
+
+	sr := newSliceReader(b)
+	for sr.Len() > 0 {
+		start := sr.off
+		for i, col := range w.options.columns {
+			if err := Skip(sr, col.tp); err != nil {
+				return w.valueErr(i, w.offset(), err)
+			}
+		}
+		if err := w.WriteRawRow(b[start:sr.off]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package rowbinary
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_Raw(t *testing.T) {
+	assert := assert.New(t)
+
+	columns := []FormatOption{C("id", UInt64), C("payload", Map(String, Array(String))), C("name", Nullable(String))}
+	payload := map[string][]string{"k": {string(bytes.Repeat([]byte("x"), 100)), ""}}
+
+	var src bytes.Buffer
+	w := NewFormatWriter(&src, append(columns, RowBinary)...)
+	for i := range 3 {
+		assert.NoError(w.WriteAny(uint64(i), payload, pointer("n")))
+	}
+
+	for _, format := range []Format{RowBinary, RowBinaryWithDefaults, Native} {
+		// small buffer to copy long values in chunks
+		r := NewFormatReader(bufio.NewReaderSize(bytes.NewReader(src.Bytes()), 16), append(columns, RowBinary)...)
+		var dst bytes.Buffer
+		w := NewFormatWriter(&dst, append(columns, format)...)
+		var raw []byte
+		for r.Next() {
+			var id uint64
+			assert.NoError(Scan(r, UInt64, &id))
+			assert.NoError(Write(w, UInt64, id+10))
+			var err error
+			raw, err = r.ReadRawColumn(raw[:0])
+			assert.NoError(err)
+			assert.NoError(w.WriteRawColumn(raw))
+			raw, err = r.ReadRawRow(raw[:0])
+			assert.NoError(err)
+			assert.Equal([]byte{0, 1, 'n'}, raw)
+			assert.NoError(w.WriteRawColumn(raw))
+		}
+		assert.NoError(r.Err())
+		assert.NoError(w.Flush())
+
+		if format == RowBinaryWithDefaults {
+			// not readable, compare with regular writer
+			var expected bytes.Buffer
+			ew := NewFormatWriter(&expected, append(columns, format)...)
+			for i := range 3 {
+				assert.NoError(ew.WriteAny(uint64(i+10), payload, pointer("n")))
+			}
+			assert.Equal(expected.Bytes(), dst.Bytes())
+			continue
+		}
+
+		r = NewFormatReader(bytes.NewReader(dst.Bytes()), append(columns, format)...)
+		i := uint64(10)
+		for r.Next() {
+			var id uint64
+			var p map[string][]string
+			var name *string
+			assert.NoError(r.Scan(&id, &p, &name))
+			assert.Equal(i, id)
+			assert.Equal(payload, p)
+			assert.Equal(pointer("n"), name)
+			i++
+		}
+		assert.NoError(r.Err())
+		assert.Equal(uint64(13), i, format.String())
+	}
+}
+
+func TestFormatWriter_WriteRawRow(t *testing.T) {
+	assert := assert.New(t)
+
+	columns := []FormatOption{C("a", UInt8), C("s", String)}
+	var src bytes.Buffer
+	w := NewFormatWriter(&src, append(columns, RowBinary)...)
+	assert.NoError(w.WriteAny(uint8(1), "a", uint8(2), "bc"))
+
+	for _, format := range []Format{RowBinary, RowBinaryWithDefaults, Native} {
+		var expected bytes.Buffer
+		ew := NewFormatWriter(&expected, append(columns, format)...)
+		assert.NoError(ew.WriteAny(uint8(1), "a", uint8(2), "bc"))
+		assert.NoError(ew.Flush())
+
+		r := NewFormatReader(bytes.NewReader(src.Bytes()), append(columns, RowBinary)...)
+		var dst bytes.Buffer
+		w := NewFormatWriter(&dst, append(columns, format)...)
+		for r.Next() {
+			raw, err := r.ReadRawRow(nil)
+			assert.NoError(err)
+			assert.NoError(w.WriteRawRow(raw))
+		}
+		assert.NoError(r.Err())
+		assert.NoError(w.Flush())
+		assert.Equal(expected.Bytes(), dst.Bytes(), format.String())
+	}
+
+	w = NewFormatWriter(&bytes.Buffer{}, append(columns, Native)...)
+	assert.ErrorContains(w.WriteRawRow([]byte{1, 1, 'a', 0}), "raw row has 1 extra bytes")
+	w = NewFormatWriter(&bytes.Buffer{}, append(columns, Native)...)
+	assert.Error(w.WriteRawRow([]byte{1, 5, 'a'}))
+}
+
+func TestFormatWriter_writeRawRows(t *testing.T) {
+	assert := assert.New(t)
+	columns := []FormatOption{C("n", UInt32), C("s", Nullable(String))}
+
+	var halves [2]bytes.Buffer
+	for h := range halves {
+		w := NewFormatWriter(&halves[h], columns...)
+		for i := h * 50; i < (h+1)*50; i++ {
+			assert.NoError(w.WriteRow(uint32(i), pointer(fmt.Sprint(i))))
+		}
+		assert.NoError(w.Finish())
+	}
+
+	for _, format := range []Format{RowBinary, RowBinaryWithDefaults, Native} {
+		var expected, actual bytes.Buffer
+		w := NewFormatWriter(&expected, append(columns, format)...)
+		for i := range 100 {
+			assert.NoError(w.WriteRow(uint32(i), pointer(fmt.Sprint(i))))
+		}
+		assert.NoError(w.Finish())
+
+		w = NewFormatWriter(&actual, append(columns, format)...)
+		assert.NoError(w.writeRawRows(halves[0].Bytes(), 50, RowBinary))
+		assert.NoError(w.writeRawRows(halves[1].Bytes(), 50, RowBinary))
+		assert.NoError(w.Finish())
+		assert.Equal(int64(100), w.Rows())
+		assert.Equal(expected.Bytes(), actual.Bytes(), format.String())
+	}
+}
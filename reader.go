@@ -12,9 +12,160 @@ type Reader interface {
 	Discard(n int) (discarded int, err error)
 }
 
+// DefaultReadBufferSize is the size of buffer used by NewReader
+const DefaultReadBufferSize = 1024 * 1024
+
+// readChunkSize is the size of data copied at once when value is larger than the read buffer
+const readChunkSize = 64 * 1024
+
 func NewReader(r io.Reader) Reader {
+	return NewReaderSize(r, DefaultReadBufferSize)
+}
+
+// NewReaderSize is like NewReader with buffer of size bytes. Values larger than the buffer are read in chunks.
+func NewReaderSize(r io.Reader, size int) Reader {
 	if rr, ok := r.(Reader); ok {
 		return rr
 	}
-	return bufio.NewReaderSize(r, 1024*1024)
+	if size <= 0 {
+		size = DefaultReadBufferSize
+	}
+	return bufio.NewReaderSize(r, size)
+}
+
+// readAppend appends n bytes read from r to dst.
+// Values which don't fit the buffer of r are copied in chunks instead of Peek
+func readAppend(dst []byte, r Reader, n int) ([]byte, error) {
+	b, err := r.Peek(n)
+	if err == nil {
+		dst = append(dst, b...)
+		_, err = r.Discard(n)
+		return dst, err
+	}
+	if err != bufio.ErrBufferFull {
+		return dst, err
+	}
+
+	for n > 0 {
+		b, err := r.Peek(min(n, readChunkSize))
+		if len(b) == 0 {
+			// bufio.Reader reports ErrBufferFull with empty result at the end of stream
+			if err == nil || err == io.EOF || err == bufio.ErrBufferFull {
+				err = io.ErrUnexpectedEOF
+			}
+			return dst, err
+		}
+		dst = append(dst, b...)
+		d, err := r.Discard(len(b))
+		n -= d
+		if err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
+// sliceReader is Reader over byte slice
+type sliceReader struct {
+	b   []byte
+	off int
+}
+
+func newSliceReader(b []byte) *sliceReader {
+	return &sliceReader{b: b}
+}
+
+func (r *sliceReader) reset(b []byte) {
+	r.b = b
+	r.off = 0
+}
+
+// Len returns number of unread bytes
+func (r *sliceReader) Len() int {
+	return len(r.b) - r.off
+}
+
+func (r *sliceReader) Read(p []byte) (int, error) {
+	if r.off >= len(r.b) {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+	n := copy(p, r.b[r.off:])
+	r.off += n
+	return n, nil
+}
+
+func (r *sliceReader) ReadByte() (byte, error) {
+	if r.off >= len(r.b) {
+		return 0, io.EOF
+	}
+	r.off++
+	return r.b[r.off-1], nil
+}
+
+func (r *sliceReader) UnreadByte() error {
+	if r.off <= 0 {
+		return bufio.ErrInvalidUnreadByte
+	}
+	r.off--
+	return nil
+}
+
+func (r *sliceReader) Peek(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, bufio.ErrNegativeCount
+	}
+	if r.off+n > len(r.b) {
+		return r.b[r.off:], io.EOF
+	}
+	return r.b[r.off : r.off+n], nil
+}
+
+func (r *sliceReader) Discard(n int) (int, error) {
+	if n < 0 {
+		return 0, bufio.ErrNegativeCount
+	}
+	if r.off+n > len(r.b) {
+		d := len(r.b) - r.off
+		r.off = len(r.b)
+		return d, io.EOF
+	}
+	r.off += n
+	return n, nil
+}
+
+// countingReader counts bytes consumed from the wrapped Reader
+type countingReader struct {
+	Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func (r *countingReader) ReadByte() (byte, error) {
+	b, err := r.Reader.ReadByte()
+	if err == nil {
+		r.n++
+	}
+	return b, err
+}
+
+func (r *countingReader) UnreadByte() error {
+	err := r.Reader.UnreadByte()
+	if err == nil {
+		r.n--
+	}
+	return err
+}
+
+func (r *countingReader) Discard(n int) (int, error) {
+	n, err := r.Reader.Discard(n)
+	r.n += int64(n)
+	return n, err
 }
@@ -0,0 +1,55 @@
+package rowbinary
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_LargeValues(t *testing.T) {
+	assert := assert.New(t)
+
+	large := strings.Repeat("x", 100*1024+7)
+	cols := []FormatOption{
+		C("s", String),
+		C("b", StringBytes),
+		C("a", Array(String)),
+		C("m", Map(String, String)),
+	}
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, cols...)
+	for range 3 {
+		assert.NoError(w.WriteAny(large, []byte(large), []string{"a", large}, map[string]string{large: large}))
+	}
+	assert.NoError(w.Finish())
+
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), append(cols, WithReadBufferSize(16))...)
+	rows := 0
+	for r.Next() {
+		var s string
+		var b []byte
+		var a []string
+		var m map[string]string
+		assert.NoError(Scan(r, String, &s))
+		assert.NoError(Scan(r, StringBytes, &b))
+		assert.NoError(Scan(r, Array(String), &a))
+		assert.NoError(Scan(r, Map(String, String), &m))
+		assert.Equal(large, s)
+		assert.Equal(large, string(b))
+		assert.Equal([]string{"a", large}, a)
+		assert.Equal(map[string]string{large: large}, m)
+		rows++
+	}
+	assert.NoError(r.Err())
+	assert.Equal(3, rows)
+
+	// truncated value
+	r = NewFormatReader(bytes.NewReader(buf.Bytes()[:50*1024]), append(cols, WithReadBufferSize(16))...)
+	assert.True(r.Next())
+	var s string
+	assert.ErrorIs(Scan(r, String, &s), io.ErrUnexpectedEOF)
+}
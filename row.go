@@ -0,0 +1,124 @@
+package rowbinary
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Row holds values of the current row read by FormatReader.ReadRow.
+// Values are accessed by column name with Get, positions of columns are resolved once from the header.
+// Row is reused between ReadRow calls, so values must be copied if they are needed after the next row.
+type Row struct {
+	columns []Column
+	names   map[string]int
+	values  []any
+}
+
+// NewRow creates empty reusable row.
+func NewRow() *Row {
+	return &Row{}
+}
+
+// ReadRow reads all values of the current row into row. Must be called after Next.
+// Row contains columns decoded according to WithProjection option and SchemaMode,
+// declared columns missing in result have values set with Column.WithDefault.
+func (r *FormatReader) ReadRow(row *Row) error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	if r.index != 0 {
+		return r.setErr(errors.New("row must be read from the beginning"))
+	}
+
+	row.setColumns(r.rowColumns)
+	j := 0
+	for i := range r.columns {
+		off := r.offset()
+		if r.skipped != nil && r.skipped[i] {
+			if err := Skip(r.wrap, r.columns[i].tp); err != nil {
+				return r.valueErr(i, off, err)
+			}
+			continue
+		}
+		row.values[j] = nil
+		if err := r.columns[i].tp.ScanAny(r.wrap, &row.values[j]); err != nil {
+			return r.valueErr(i, off, err)
+		}
+		j++
+	}
+	r.rows++
+	r.limit.rowBytes = 0
+	for _, col := range r.missing {
+		row.values[j] = col.def
+		j++
+	}
+	return nil
+}
+
+// setColumns resolves column positions if columns are changed
+func (row *Row) setColumns(columns []Column) {
+	if len(row.columns) == len(columns) && (len(columns) == 0 || &row.columns[0] == &columns[0]) {
+		return
+	}
+
+	row.columns = columns
+	row.names = make(map[string]int, len(columns))
+	for i := range columns {
+		row.names[columns[i].name] = i
+	}
+	row.values = make([]any, len(columns))
+}
+
+// Len returns number of columns in row.
+func (row *Row) Len() int {
+	return len(row.values)
+}
+
+// Column returns i-th column of row.
+func (row *Row) Column(i int) Column {
+	return row.columns[i]
+}
+
+// Index returns position of column with name.
+func (row *Row) Index(name string) (int, bool) {
+	i, ok := row.names[name]
+	return i, ok
+}
+
+// At returns value of i-th column.
+func (row *Row) At(i int) any {
+	return row.values[i]
+}
+
+// Value returns value of column with name.
+func (row *Row) Value(name string) (any, error) {
+	i, ok := row.names[name]
+	if !ok {
+		return nil, fmt.Errorf("column %s not found", name)
+	}
+	return row.values[i], nil
+}
+
+// Map returns new map with values of row by column names. Intended for debugging.
+func (row *Row) Map() map[string]any {
+	m := make(map[string]any, len(row.values))
+	for i, v := range row.values {
+		m[row.columns[i].name] = v
+	}
+	return m
+}
+
+// Get returns value of column with name as T.
+func Get[T any](row *Row, name string) (T, error) {
+	var zero T
+	v, err := row.Value(name)
+	if err != nil {
+		return zero, err
+	}
+	tv, ok := v.(T)
+	if !ok {
+		return zero, fmt.Errorf("column %s has value of type %T, not %s", name, v, reflect.TypeFor[T]())
+	}
+	return tv, nil
+}
@@ -0,0 +1,52 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_ReadRow(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithNamesAndTypes, C("b", String), C("a", UInt32), C("c", Nullable(Int8)))
+	assert.NoError(w.WriteAny("x", uint32(1), pointer(int8(-1))))
+	assert.NoError(w.WriteAny("y", uint32(2), (*int8)(nil)))
+
+	// columns in options are in different order
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes, C("a", UInt32), C("c", Nullable(Int8)), C("b", String))
+	row := NewRow()
+
+	assert.True(r.Next())
+	assert.NoError(r.ReadRow(row))
+	a, err := Get[uint32](row, "a")
+	assert.NoError(err)
+	assert.Equal(uint32(1), a)
+	b, err := Get[string](row, "b")
+	assert.NoError(err)
+	assert.Equal("x", b)
+	c, err := Get[*int8](row, "c")
+	assert.NoError(err)
+	assert.Equal(pointer(int8(-1)), c)
+	assert.Equal(map[string]any{"a": uint32(1), "b": "x", "c": pointer(int8(-1))}, row.Map())
+
+	_, err = Get[int](row, "a")
+	assert.ErrorContains(err, "column a has value of type uint32, not int")
+	_, err = Get[int](row, "unknown")
+	assert.ErrorContains(err, "column unknown not found")
+
+	assert.True(r.Next())
+	assert.NoError(r.ReadRow(row))
+	i, ok := row.Index("a")
+	assert.True(ok)
+	assert.Equal(1, i)
+	assert.Equal(uint32(2), row.At(i))
+	c, err = Get[*int8](row, "c")
+	assert.NoError(err)
+	assert.Nil(c)
+
+	assert.False(r.Next())
+	assert.NoError(r.Err())
+}
@@ -0,0 +1,137 @@
+package rowbinary
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SchemaMode defines how FormatReader matches columns declared in options with columns of result.
+// It is used as an option itself: NewFormatReader(r, RowBinaryWithNamesAndTypes, SchemaTolerant, C("id", UInt64)).
+type SchemaMode int
+
+var _ FormatOption = SchemaStrict
+
+const (
+	// SchemaDefault uses types of declared columns for matching result columns, other result columns
+	// are read with types from header (RowBinaryWithNamesAndTypes and Native) or fail (RowBinaryWithNames)
+	SchemaDefault SchemaMode = 0
+	// SchemaStrict requires result to have exactly declared columns in any order
+	SchemaStrict SchemaMode = 1
+	// SchemaTolerant skips result columns which are not declared and reports declared columns absent in result
+	// with MissingColumns. Row contains defaults of missing columns set with Column.WithDefault.
+	// RowBinaryWithNames has no types in header, so undeclared columns can't be skipped and still fail
+	SchemaTolerant SchemaMode = 2
+)
+
+func (m SchemaMode) String() string {
+	switch m {
+	case SchemaDefault:
+		return "SchemaDefault"
+	case SchemaStrict:
+		return "SchemaStrict"
+	case SchemaTolerant:
+		return "SchemaTolerant"
+	default:
+		return "Unknown"
+	}
+}
+
+func (m SchemaMode) applyFormatOption(o *formatOptions) {
+	o.schemaMode = m
+}
+
+func (m SchemaMode) applySelectOptions(o *selectOptions) {
+	o.formatOptions = append(o.formatOptions, m)
+}
+
+func (m SchemaMode) applyClientOptions(opts *clientOptions) {
+	opts.defaultSelect = append(opts.defaultSelect, m)
+}
+
+// matchColumns checks result columns against declared ones according to schema mode
+// and marks columns skipped because of projection or schema mode
+func (r *FormatReader) matchColumns() error {
+	r.decoded = r.columns
+	r.rowColumns = r.columns
+	r.skipped = nil
+	r.missing = nil
+	checkSchema := r.options.format != RowBinary && r.options.schemaMode != SchemaDefault
+	if len(r.columns) == 0 || (!checkSchema && len(r.options.projection) == 0) {
+		return nil
+	}
+	defer func() {
+		if len(r.missing) > 0 {
+			r.rowColumns = append(slices.Clip(r.decoded), r.missing...)
+		} else {
+			r.rowColumns = r.decoded
+		}
+	}()
+
+	skipped := make([]bool, len(r.columns))
+
+	if checkSchema {
+		remote := make(map[string]bool, len(r.columns))
+		for _, col := range r.columns {
+			remote[col.name] = true
+		}
+		declared := make(map[string]bool, len(r.options.columns))
+		for _, col := range r.options.columns {
+			declared[col.name] = true
+			if remote[col.name] {
+				continue
+			}
+			if r.options.schemaMode == SchemaStrict {
+				return fmt.Errorf("column %s is missing in result", col.name)
+			}
+			r.missing = append(r.missing, col)
+		}
+		for i, col := range r.columns {
+			if declared[col.name] {
+				continue
+			}
+			if r.options.schemaMode == SchemaStrict {
+				return fmt.Errorf("column %s is not declared", col.name)
+			}
+			skipped[i] = true
+		}
+	}
+
+	if len(r.options.projection) > 0 {
+		for i := range skipped {
+			skipped[i] = true
+		}
+		for _, name := range r.options.projection {
+			found := false
+			for i := range r.columns {
+				if r.columns[i].name == name {
+					skipped[i] = false
+					found = true
+				}
+			}
+			for _, col := range r.missing {
+				if col.name == name {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("projected column %s not found", name)
+			}
+		}
+	}
+
+	if !slices.Contains(skipped, true) {
+		return nil
+	}
+
+	r.skipped = skipped
+	r.decoded = nil
+	for i := range r.columns {
+		if !skipped[i] {
+			r.decoded = append(r.decoded, r.columns[i])
+		}
+	}
+	if len(r.decoded) == 0 {
+		return fmt.Errorf("no columns to read")
+	}
+	return nil
+}
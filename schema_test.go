@@ -0,0 +1,72 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatReader_SchemaMode(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithNamesAndTypes, C("new", Array(String)), C("id", UInt64), C("name", String))
+	assert.NoError(w.WriteAny([]string{"x"}, uint64(1), "a"))
+	assert.NoError(w.WriteAny([]string{}, uint64(2), "b"))
+	data := buf.Bytes()
+
+	declared := []FormatOption{RowBinaryWithNamesAndTypes, C("name", String), C("id", UInt64), C("old", UInt32).WithDefault(uint32(7))}
+
+	// default mode doesn't notice missing column and reads undeclared one
+	r := NewFormatReader(bytes.NewReader(data), declared...)
+	cols, err := r.Columns()
+	assert.NoError(err)
+	assert.Equal(3, cols.Len())
+
+	r = NewFormatReader(bytes.NewReader(data), append(declared, SchemaStrict)...)
+	_, err = r.Columns()
+	assert.ErrorContains(err, "column old is missing in result")
+
+	r = NewFormatReader(bytes.NewReader(data), RowBinaryWithNamesAndTypes, SchemaStrict, C("id", UInt64), C("name", String))
+	_, err = r.Columns()
+	assert.ErrorContains(err, "column new is not declared")
+
+	r = NewFormatReader(bytes.NewReader(data), append(declared, SchemaTolerant)...)
+	cols, err = r.Columns()
+	assert.NoError(err)
+	assert.Equal(2, cols.Len())
+	assert.Equal("id", cols.At(0).Name())
+	assert.Equal("name", cols.At(1).Name())
+	missing, err := r.MissingColumns()
+	assert.NoError(err)
+	assert.Len(missing, 1)
+	assert.Equal("old", missing[0].Name())
+
+	row := NewRow()
+	var rows []map[string]any
+	for r.Next() {
+		assert.NoError(r.ReadRow(row))
+		rows = append(rows, row.Map())
+	}
+	assert.NoError(r.Err())
+	assert.Equal([]map[string]any{
+		{"id": uint64(1), "name": "a", "old": uint32(7)},
+		{"id": uint64(2), "name": "b", "old": uint32(7)},
+	}, rows)
+
+	// positional scan reads matched columns only
+	r = NewFormatReader(bytes.NewReader(data), append(declared, SchemaTolerant)...)
+	var ids []uint64
+	var names []string
+	for r.Next() {
+		var id uint64
+		var name string
+		assert.NoError(r.Scan(&id, &name))
+		ids = append(ids, id)
+		names = append(names, name)
+	}
+	assert.NoError(r.Err())
+	assert.Equal([]uint64{1, 2}, ids)
+	assert.Equal([]string{"a", "b"}, names)
+}
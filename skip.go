@@ -0,0 +1,89 @@
+package rowbinary
+
+import (
+	"io"
+)
+
+// SkipType is implemented by types which can skip encoded value without decoding it.
+// All built-in types implement it, strings and arrays of fixed-width values are skipped with Reader.Discard.
+//
+// Types created with MakeType and MakeTypeWrapAny delegate to the wrapped implementation
+// or fall back to Scan into throwaway value.
+type SkipType interface {
+	Skip(r Reader) error
+}
+
+var _ SkipType = &typeWrapper[uint8]{}
+
+// Skip skips value of type tp in r.
+func Skip(r Reader, tp Any) error {
+	if s, ok := tp.(SkipType); ok {
+		return s.Skip(r)
+	}
+	var v any
+	return tp.ScanAny(r, &v)
+}
+
+func (t *typeWrapper[T]) Skip(r Reader) error {
+	if s, ok := t.PreType.(SkipType); ok {
+		return s.Skip(r)
+	}
+	var v T
+	return t.PreType.Scan(r, &v)
+}
+
+func (t typeWrapperAny[T]) Skip(r Reader) error {
+	if s, ok := t.BaseType.(SkipType); ok {
+		return s.Skip(r)
+	}
+	var v T
+	return t.BaseType.Scan(r, &v)
+}
+
+func (t *customType[T]) Skip(r Reader) error {
+	return Skip(r, t.Type)
+}
+
+// skipBytes discards exactly n bytes
+func skipBytes(r Reader, n int) error {
+	_, err := r.Discard(n)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// skipString discards length-prefixed string
+func skipString(r Reader) error {
+	n, err := readStringLen(r)
+	if err != nil {
+		return err
+	}
+	return skipBytes(r, n)
+}
+
+// skipN skips n values of type tp, values of fixed width are discarded at once
+func skipN(r Reader, tp Any, n int) error {
+	if w, ok := nativeFixedWidth[[1]byte{tp.Binary()[0]}]; ok {
+		return skipBytes(r, n*w)
+	}
+	for i := 0; i < n; i++ {
+		if err := Skip(r, tp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipPairs skips n key-value pairs of map
+func skipPairs(r Reader, keyType Any, valueType Any, n int) error {
+	for i := 0; i < n; i++ {
+		if err := Skip(r, keyType); err != nil {
+			return err
+		}
+		if err := Skip(r, valueType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package rowbinary
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEncodeValues contains values of every built-in type for encoding tests
+var testEncodeValues = []Value{
+	{UInt8, uint8(1)},
+	{Int16, int16(-2)},
+	{UInt64, uint64(3)},
+	{Float32, float32(1.5)},
+	{Bool, true},
+	{String, "hello"},
+	{StringBytes, []byte("world")},
+	{FixedString(3), []byte("abc")},
+	{UUID, uuid.MustParse("258b07b7-daa1-4c80-8062-58a2e07c2601")},
+	{Date, ValueDate{2025, 1, 2}},
+	{Date32, ValueDate{2025, 1, 2}},
+	{DateTime, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+	{DateTime64(3), time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+	{DateTimeTZ("UTC"), time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+	{DateTime64TZ(6, "UTC"), time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+	{Decimal(9, 2), decimal.New(1, 0)},
+	{Decimal(18, 2), decimal.New(1, 0)},
+	{Enum8(map[string]int8{"a": 1}), "a"},
+	{Enum16(map[string]int16{"a": 1}), "a"},
+	{IntervalSecond, int64(10)},
+	{IPv4, [4]byte{127, 0, 0, 1}},
+	{IPv6, [16]byte{1}},
+	{Nullable(String), pointer("x")},
+	{Nullable(String), (*string)(nil)},
+	{NullableAny(String), pointer(any("x"))},
+	{Nullable(Nothing), (*any)(nil)},
+	{Array(UInt32), []uint32{1, 2, 3}},
+	{Array(String), []string{"a", "bc"}},
+	{ArrayAny(Nullable(UInt8)), []any{pointer(uint8(1)), (*uint8)(nil)}},
+	{Map(String, Array(UInt16)), map[string][]uint16{"a": {1, 2}}},
+	{Array(UInt16), []uint16{}},
+	{MapAny(String, String), map[any]any{"a": "b"}},
+	{MapKV(String, UInt8), NewKV[string, uint8]().Append("a", 1)},
+	{LowCardinality(String), "lc"},
+	{LowCardinalityAny(String), "lc"},
+	{TupleAny(UInt8, String), []any{uint8(1), "s"}},
+	{TupleNamedAny(C("a", String), C("b", Float64)), []any{"s", float64(1)}},
+	{Variant(String, UInt64), Value{UInt64, uint64(1)}},
+	{Dynamic(0), Value{Array(String), []string{"a"}}},
+	{Point, []any{float64(1), float64(2)}},
+	{TypeFor[[]int](), []int{1, 2}},
+}
+
+func TestSkip(t *testing.T) {
+	for _, v := range testEncodeValues {
+		t.Run(v.Type.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			assert.NoError(v.Type.WriteAny(w, v.Value))
+			assert.NoError(UInt8.Write(w, 42))
+
+			r := NewReader(bytes.NewReader(buf.Bytes()))
+			assert.NoError(Skip(r, v.Type))
+			var tail uint8
+			assert.NoError(UInt8.Scan(r, &tail))
+			assert.Equal(uint8(42), tail)
+		})
+	}
+
+	r := NewReader(bytes.NewReader([]byte{5, 'a'}))
+	assert.Error(t, Skip(r, String))
+}
+
+func TestFormatReader_Skip(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	w := NewFormatWriter(&buf, RowBinaryWithNamesAndTypes, C("a", UInt32), C("b", Array(String)), C("c", String))
+	for i := range 3 {
+		assert.NoError(w.WriteAny(uint32(i), []string{"x", "y"}, "z"))
+	}
+
+	r := NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes)
+	assert.True(r.Next())
+	assert.NoError(r.SkipRow())
+	assert.True(r.Next())
+	var a uint32
+	var c string
+	assert.NoError(Scan(r, UInt32, &a))
+	assert.NoError(r.SkipColumn())
+	assert.NoError(Scan(r, String, &c))
+	assert.Equal(uint32(1), a)
+	assert.Equal("z", c)
+	assert.True(r.Next())
+	assert.NoError(Scan(r, UInt32, &a))
+	assert.NoError(r.SkipRow())
+	assert.Equal(uint32(2), a)
+	assert.False(r.Next())
+	assert.NoError(r.Err())
+
+	// projection
+	r = NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes, WithProjection("c", "a"))
+	var rows []string
+	for r.Next() {
+		assert.NoError(r.Scan(&a, &c))
+		rows = append(rows, fmt.Sprint(a, c))
+	}
+	assert.NoError(r.Err())
+	assert.Equal([]string{"0z", "1z", "2z"}, rows)
+
+	r = NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes, WithProjection("c"))
+	row := NewRow()
+	assert.True(r.Next())
+	assert.NoError(r.ReadRow(row))
+	assert.Equal(map[string]any{"c": "z"}, row.Map())
+
+	// leading or trailing columns excluded by projection, read to the end of stream
+	for _, projection := range []string{"a", "c"} {
+		for _, read := range []func(r *FormatReader) error{
+			func(r *FormatReader) error {
+				if projection == "a" {
+					return r.Scan(&a)
+				}
+				return r.Scan(&c)
+			},
+			func(r *FormatReader) error {
+				if projection == "a" {
+					return Scan(r, UInt32, &a)
+				}
+				return Scan(r, String, &c)
+			},
+			func(r *FormatReader) error { return r.SkipColumn() },
+			func(r *FormatReader) error { _, err := r.ReadRawColumn(nil); return err },
+		} {
+			r = NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes, WithProjection(projection))
+			n := 0
+			for r.Next() {
+				assert.NoError(read(r))
+				n++
+			}
+			assert.NoError(r.Err(), projection)
+			assert.Equal(3, n, projection)
+		}
+	}
+
+	r = NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes, WithProjection("a"))
+	col := NewColumnBuffer(UInt32, 0)
+	n, err := r.ReadBatch(10, col)
+	assert.NoError(err)
+	assert.Equal(3, n)
+	assert.Equal([]uint32{0, 1, 2}, col.Values)
+
+	r = NewFormatReader(bytes.NewReader(buf.Bytes()), RowBinaryWithNamesAndTypes, WithProjection("unknown"))
+	assert.False(r.Next())
+	assert.ErrorContains(r.Err(), "projected column unknown not found")
+}
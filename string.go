@@ -1,6 +1,7 @@
 package rowbinary
 
 import (
+	"bufio"
 	"encoding/binary"
 	"unsafe"
 )
@@ -36,18 +37,46 @@ func (t typeString) Write(w Writer, value string) error {
 	return err
 }
 
+func (t typeString) Append(dst []byte, v string) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+	return append(dst, v...), nil
+}
+
 func (t typeString) Scan(r Reader, v *string) (err error) {
-	n, err := binary.ReadUvarint(r)
+	n, err := readStringLen(r)
 	if err != nil {
 		return err
 	}
 
-	buf, err := r.Peek(int(n))
+	buf, err := r.Peek(n)
+	if err == bufio.ErrBufferFull {
+		// value is larger than read buffer
+		buf, err = readAppend(nil, r, n)
+		*v = string(buf)
+		return err
+	}
 	if err != nil {
 		return err
 	}
 
 	*v = string(buf[:n])
-	_, err = r.Discard(int(n))
+	_, err = r.Discard(n)
 	return err
 }
+
+func (t typeString) Skip(r Reader) error {
+	return skipString(r)
+}
+
+func (t typeString) AppendLiteral(dst []byte, v string) ([]byte, error) {
+	return AppendQuotedLiteral(dst, v), nil
+}
+
+func (t typeString) ScanLiteral(s *LiteralScanner, v *string) (err error) {
+	*v, err = s.Quoted()
+	return err
+}
+
+func (t typeString) AppendJSON(dst []byte, v string, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, v), nil
+}
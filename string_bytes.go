@@ -25,20 +25,38 @@ func (t typeStringBytes) Write(w Writer, value []byte) error {
 	return err
 }
 
+func (t typeStringBytes) Append(dst []byte, v []byte) ([]byte, error) {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+	return append(dst, v...), nil
+}
+
 func (t typeStringBytes) Scan(r Reader, v *[]byte) (err error) {
-	n, err := binary.ReadUvarint(r)
+	n, err := readStringLen(r)
 	if err != nil {
 		return err
 	}
 
-	*v = (*v)[:0]
+	*v, err = readAppend((*v)[:0], r, n)
+	return err
+}
+
+func (t typeStringBytes) Skip(r Reader) error {
+	return skipString(r)
+}
+
+func (t typeStringBytes) AppendLiteral(dst []byte, v []byte) ([]byte, error) {
+	return AppendQuotedLiteral(dst, string(v)), nil
+}
 
-	p, err := r.Peek(int(n))
+func (t typeStringBytes) ScanLiteral(s *LiteralScanner, v *[]byte) error {
+	q, err := s.Quoted()
 	if err != nil {
 		return err
 	}
+	*v = append((*v)[:0], q...)
+	return nil
+}
 
-	*v = append(*v, p...)
-	_, err = r.Discard(int(n))
-	return err
+func (t typeStringBytes) AppendJSON(dst []byte, v []byte, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, string(v)), nil
 }
@@ -139,6 +139,15 @@ func TestType[T any](t *testing.T, tp Type[T], value T, query string) {
 		assert.Equal(value, v2)
 	})
 
+	// Skip test
+	t.Run(fmt.Sprintf("%s/skip%s", tp.String(), caller), func(t *testing.T) {
+		assert := assert.New(t)
+		r := NewReader(bytes.NewBuffer(bodyEncoded))
+		assert.NoError(Skip(r, tp))
+		_, err := r.ReadByte()
+		assert.ErrorIs(err, io.EOF)
+	})
+
 	// compare WriteAny with clickhouse
 	t.Run(fmt.Sprintf("%s/write_any%s", tp.String(), caller), func(t *testing.T) {
 		assert := assert.New(t)
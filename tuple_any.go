@@ -60,6 +60,19 @@ func (t typeTupleAny) Write(w Writer, value []any) error {
 	return nil
 }
 
+func (t typeTupleAny) Append(dst []byte, v []any) ([]byte, error) {
+	if len(v) != len(t.valueTypes) {
+		return dst, errors.New("invalid tuple length")
+	}
+	var err error
+	for i := range v {
+		if dst, err = appendAny(dst, t.valueTypes[i], v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
 func (t typeTupleAny) Scan(r Reader, v *[]any) error {
 	*v = (*v)[:0]
 	for i := 0; i < len(t.valueTypes); i++ {
@@ -73,3 +86,67 @@ func (t typeTupleAny) Scan(r Reader, v *[]any) error {
 
 	return nil
 }
+
+func (t typeTupleAny) Skip(r Reader) error {
+	for _, tp := range t.valueTypes {
+		if err := Skip(r, tp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t typeTupleAny) coerce(v any) ([]any, error) {
+	elems, ok := coerceElems(v)
+	if !ok {
+		return nil, newTypeMismatchError[[]any](v)
+	}
+	if len(elems) != len(t.valueTypes) {
+		return nil, errors.New("invalid tuple length")
+	}
+	for i, elem := range elems {
+		var err error
+		if elems[i], err = coerceAny(t.valueTypes[i], elem); err != nil {
+			return nil, err
+		}
+	}
+	return elems, nil
+}
+
+func (t typeTupleAny) AppendLiteral(dst []byte, value []any) ([]byte, error) {
+	if len(value) != len(t.valueTypes) {
+		return dst, errors.New("invalid tuple length")
+	}
+	return appendTupleLiteral(dst, len(value), func(dst []byte, i int) ([]byte, error) {
+		return appendLiteralAny(dst, t.valueTypes[i], value[i])
+	})
+}
+
+func (t typeTupleAny) ScanLiteral(s *LiteralScanner, v *[]any) error {
+	*v = (*v)[:0]
+	return s.scanTuple(len(t.valueTypes), func(i int) error {
+		x, err := scanLiteralAny(s, t.valueTypes[i])
+		if err != nil {
+			return err
+		}
+		*v = append(*v, x)
+		return nil
+	})
+}
+
+func (t typeTupleAny) AppendJSON(dst []byte, value []any, opts *JSONOptions) ([]byte, error) {
+	if len(value) != len(t.valueTypes) {
+		return dst, errors.New("invalid tuple length")
+	}
+	dst = append(dst, '[')
+	for i := range value {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		var err error
+		if dst, err = appendJSONAny(dst, t.valueTypes[i], value[i], opts); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
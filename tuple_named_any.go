@@ -61,6 +61,19 @@ func (t typeTupleNamedAny) Write(w Writer, value []any) error {
 	return nil
 }
 
+func (t typeTupleNamedAny) Append(dst []byte, v []any) ([]byte, error) {
+	if len(v) != len(t.columns) {
+		return dst, errors.New("invalid tuple length")
+	}
+	var err error
+	for i := range v {
+		if dst, err = appendAny(dst, t.columns[i].tp, v[i]); err != nil {
+			return dst, err
+		}
+	}
+	return dst, nil
+}
+
 func (t typeTupleNamedAny) Scan(r Reader, v *[]any) error {
 	*v = (*v)[:0]
 	for i := 0; i < len(t.columns); i++ {
@@ -74,3 +87,75 @@ func (t typeTupleNamedAny) Scan(r Reader, v *[]any) error {
 
 	return nil
 }
+
+func (t typeTupleNamedAny) Skip(r Reader) error {
+	for _, col := range t.columns {
+		if err := Skip(r, col.tp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t typeTupleNamedAny) coerce(v any) ([]any, error) {
+	elems, ok := coerceElems(v)
+	if !ok {
+		return nil, newTypeMismatchError[[]any](v)
+	}
+	if len(elems) != len(t.columns) {
+		return nil, errors.New("invalid tuple length")
+	}
+	for i, elem := range elems {
+		var err error
+		if elems[i], err = coerceAny(t.columns[i].Type(), elem); err != nil {
+			return nil, err
+		}
+	}
+	return elems, nil
+}
+
+func (t typeTupleNamedAny) AppendLiteral(dst []byte, value []any) ([]byte, error) {
+	if len(value) != len(t.columns) {
+		return dst, errors.New("invalid tuple length")
+	}
+	return appendTupleLiteral(dst, len(value), func(dst []byte, i int) ([]byte, error) {
+		return appendLiteralAny(dst, t.columns[i].Type(), value[i])
+	})
+}
+
+func (t typeTupleNamedAny) ScanLiteral(s *LiteralScanner, v *[]any) error {
+	*v = (*v)[:0]
+	return s.scanTuple(len(t.columns), func(i int) error {
+		x, err := scanLiteralAny(s, t.columns[i].Type())
+		if err != nil {
+			return err
+		}
+		*v = append(*v, x)
+		return nil
+	})
+}
+
+func (t typeTupleNamedAny) AppendJSON(dst []byte, value []any, opts *JSONOptions) ([]byte, error) {
+	if len(value) != len(t.columns) {
+		return dst, errors.New("invalid tuple length")
+	}
+	open, close := byte('['), byte(']')
+	if opts.NamedTuplesAsObjects {
+		open, close = '{', '}'
+	}
+	dst = append(dst, open)
+	for i := range value {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if opts.NamedTuplesAsObjects {
+			dst = appendJSONString(dst, t.columns[i].Name())
+			dst = append(dst, ':')
+		}
+		var err error
+		if dst, err = appendJSONAny(dst, t.columns[i].Type(), value[i], opts); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, close), nil
+}
@@ -0,0 +1,219 @@
+package rowbinary
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// binaryTypeCacheLimit limits number of cached decoded types per cache
+const binaryTypeCacheLimit = 1024
+
+// binaryTypeCache maps binary type encodings to decoded types.
+// Used by Dynamic to avoid decoding (and allocating) type of every value.
+type binaryTypeCache struct {
+	sync.RWMutex
+	types map[string]Any
+	known map[uint64]Any
+}
+
+func newBinaryTypeCache(knownTypes ...Any) *binaryTypeCache {
+	c := &binaryTypeCache{
+		types: make(map[string]Any, len(knownTypes)),
+		known: make(map[uint64]Any, len(knownTypes)),
+	}
+	for _, k := range knownTypes {
+		c.types[string(k.Binary())] = k
+		c.known[k.ID()] = k
+	}
+	return c
+}
+
+// decode reads binary type from r. Known types are returned instead of decoded ones
+func (c *binaryTypeCache) decode(r Reader) (Any, error) {
+	n, err := binaryTypeLen(r)
+	if err != nil {
+		// unsupported or truncated encoding, let DecodeBinaryType report it
+		return c.resolve(DecodeBinaryType(r))
+	}
+
+	key, err := r.Peek(n)
+	if err != nil {
+		return c.resolve(DecodeBinaryType(r))
+	}
+
+	c.RLock()
+	tp, ok := c.types[string(key)]
+	c.RUnlock()
+	if ok {
+		_, err = r.Discard(n)
+		return tp, err
+	}
+
+	// key is invalidated by reading from r
+	skey := string(key)
+	tp, err = c.resolve(DecodeBinaryType(r))
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	if len(c.types) < binaryTypeCacheLimit {
+		c.types[skey] = tp
+	}
+	c.Unlock()
+	return tp, nil
+}
+
+func (c *binaryTypeCache) resolve(tp Any, err error) (Any, error) {
+	if err != nil {
+		return nil, err
+	}
+	if k, ok := c.known[tp.ID()]; ok {
+		return k, nil
+	}
+	return tp, nil
+}
+
+// binaryTypeLen returns length of binary type encoding at the beginning of r without consuming it
+func binaryTypeLen(r Reader) (int, error) {
+	p := peekCursor{r: r}
+	if err := p.skipBinaryType(); err != nil {
+		return 0, err
+	}
+	return p.off, nil
+}
+
+type peekCursor struct {
+	r     Reader
+	off   int
+	depth int // nesting level of type being skipped
+}
+
+func (p *peekCursor) byte() (byte, error) {
+	b, err := p.r.Peek(p.off + 1)
+	if err != nil {
+		return 0, err
+	}
+	p.off++
+	return b[p.off-1], nil
+}
+
+func (p *peekCursor) uvarint() (uint64, error) {
+	var x uint64
+	var s uint
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		b, err := p.byte()
+		if err != nil {
+			return 0, err
+		}
+		if b < 0x80 {
+			return x | uint64(b)<<s, nil
+		}
+		x |= uint64(b&0x7f) << s
+		s += 7
+	}
+	return 0, errVarintOverflow
+}
+
+func (p *peekCursor) skip(n uint64) error {
+	if _, err := p.r.Peek(p.off + int(n)); err != nil {
+		return err
+	}
+	p.off += int(n)
+	return nil
+}
+
+func (p *peekCursor) skipString() error {
+	n, err := p.uvarint()
+	if err != nil {
+		return err
+	}
+	return p.skip(n)
+}
+
+func (p *peekCursor) skipBinaryType() error {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxTypeDepth(p.r) {
+		return LimitError{Limit: "MaxTypeDepth", Value: uint64(p.depth), Max: int64(maxTypeDepth(p.r))}
+	}
+
+	b, err := p.byte()
+	if err != nil {
+		return err
+	}
+
+	switch [1]byte{b} {
+	case BinaryTypeDateTimeWithTimeZone, BinaryTypeCustom:
+		return p.skipString()
+	case BinaryTypeDateTime64, BinaryTypeInterval, BinaryTypeDynamic, BinaryTypeTime64:
+		return p.skip(1)
+	case BinaryTypeDateTime64WithTimeZone:
+		if err := p.skip(1); err != nil {
+			return err
+		}
+		return p.skipString()
+	case BinaryTypeFixedString:
+		_, err := p.uvarint()
+		return err
+	case BinaryTypeEnum8, BinaryTypeEnum16:
+		size := uint64(1)
+		if [1]byte{b} == BinaryTypeEnum16 {
+			size = 2
+		}
+		n, err := p.uvarint()
+		if err != nil {
+			return err
+		}
+		for range n {
+			if err := p.skipString(); err != nil {
+				return err
+			}
+			if err := p.skip(size); err != nil {
+				return err
+			}
+		}
+		return nil
+	case BinaryTypeDecimal32, BinaryTypeDecimal64, BinaryTypeDecimal128, BinaryTypeDecimal256:
+		return p.skip(2)
+	case BinaryTypeArray, BinaryTypeNullable, BinaryTypeLowCardinality:
+		return p.skipBinaryType()
+	case BinaryTypeMap:
+		if err := p.skipBinaryType(); err != nil {
+			return err
+		}
+		return p.skipBinaryType()
+	case BinaryTypeTuple, BinaryTypeVariant:
+		n, err := p.uvarint()
+		if err != nil {
+			return err
+		}
+		for range n {
+			if err := p.skipBinaryType(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case BinaryTypeTupleNamed, BinaryTypeNested:
+		n, err := p.uvarint()
+		if err != nil {
+			return err
+		}
+		for range n {
+			if err := p.skipString(); err != nil {
+				return err
+			}
+			if err := p.skipBinaryType(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case BinaryTypeSet, BinaryTypeFunction, BinaryTypeAggregateFunction, BinaryTypeSimpleAggregateFunction, BinaryTypeJSON:
+		return NotImplementedError
+	}
+
+	if b > BinaryTypeTime64[0] {
+		return NotImplementedError
+	}
+	return nil
+}
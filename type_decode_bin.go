@@ -1,7 +1,6 @@
 package rowbinary
 
 import (
-	"encoding/binary"
 	"errors"
 	"io"
 )
@@ -64,7 +63,17 @@ var (
 )
 
 // DecodeBinaryType decodes a binary type from the given reader.
+// Nesting of types is limited by Limits.MaxTypeDepth of FormatReader or DefaultMaxTypeDepth.
 func DecodeBinaryType(r Reader) (Any, error) {
+	return decodeBinaryType(r, 1, maxTypeDepth(r))
+}
+
+// decodeBinaryType decodes type at nesting level, LimitError is returned if level is greater than maxLevel
+func decodeBinaryType(r Reader, level int, maxLevel int) (Any, error) {
+	if level > maxLevel {
+		return nil, LimitError{Limit: "MaxTypeDepth", Value: uint64(level), Max: int64(maxLevel)}
+	}
+
 	var firstByte [1]byte
 	if _, err := io.ReadFull(r, firstByte[:]); err != nil {
 		return nil, err
@@ -136,18 +145,18 @@ func DecodeBinaryType(r Reader) (Any, error) {
 	case BinaryTypeString:
 		return String, nil
 	case BinaryTypeFixedString: // <var_uint_size>
-		size, err := binary.ReadUvarint(r)
+		size, err := readStringLen(r)
 		if err != nil {
 			return nil, err
 		}
 		return FixedString(int(size)), nil
 	case BinaryTypeEnum8: // <var_uint_number_of_elements><var_uint_name_size_1><name_data_1><int8_value_1>...<var_uint_name_size_N><name_data_N><int8_value_N>
-		n, err := binary.ReadUvarint(r)
+		n, err := readCollectionLen(r)
 		if err != nil {
 			return nil, err
 		}
-		mp := make(map[string]int8, n)
-		for i := 0; i < int(n); i++ {
+		mp := make(map[string]int8, preallocLen(n))
+		for i := 0; i < n; i++ {
 			var name string
 			err := String.Scan(r, &name)
 			if err != nil {
@@ -162,12 +171,12 @@ func DecodeBinaryType(r Reader) (Any, error) {
 		}
 		return Enum8(mp), nil
 	case BinaryTypeEnum16: // <var_uint_number_of_elements><var_uint_name_size_1><name_data_1><int16_little_endian_value_1>...><var_uint_name_size_N><name_data_N><int16_little_endian_value_N>
-		n, err := binary.ReadUvarint(r)
+		n, err := readCollectionLen(r)
 		if err != nil {
 			return nil, err
 		}
 		mp := make(map[string]int16)
-		for i := 0; i < int(n); i++ {
+		for i := 0; i < n; i++ {
 			var name string
 			err := String.Scan(r, &name)
 			if err != nil {
@@ -195,19 +204,19 @@ func DecodeBinaryType(r Reader) (Any, error) {
 	case BinaryTypeUUID:
 		return UUID, nil
 	case BinaryTypeArray:
-		nested, err := DecodeBinaryType(r)
+		nested, err := decodeBinaryType(r, level+1, maxLevel)
 		if err != nil {
 			return nil, err
 		}
 		return ArrayAny(nested), nil
 	case BinaryTypeTuple:
-		n, err := binary.ReadUvarint(r)
-		types := make([]Any, 0, n)
+		n, err := readCollectionLen(r)
+		types := make([]Any, 0, preallocLen(n))
 		if err != nil {
 			return nil, err
 		}
 		for range n {
-			tp, err := DecodeBinaryType(r)
+			tp, err := decodeBinaryType(r, level+1, maxLevel)
 			if err != nil {
 				return nil, err
 			}
@@ -215,18 +224,18 @@ func DecodeBinaryType(r Reader) (Any, error) {
 		}
 		return TupleAny(types...), nil
 	case BinaryTypeTupleNamed: // <var_uint_number_of_elements><var_uint_name_size_1><name_data_1><nested_type_encoding_1>...<var_uint_name_size_N><name_data_N><nested_type_encoding_N>
-		n, err := binary.ReadUvarint(r)
+		n, err := readCollectionLen(r)
 		if err != nil {
 			return nil, err
 		}
-		columns := make([]Column, 0, n)
-		for i := 0; i < int(n); i++ {
+		columns := make([]Column, 0, preallocLen(n))
+		for i := 0; i < n; i++ {
 			var name string
 			err := String.Scan(r, &name)
 			if err != nil {
 				return nil, err
 			}
-			tp, err := DecodeBinaryType(r)
+			tp, err := decodeBinaryType(r, level+1, maxLevel)
 			if err != nil {
 				return nil, err
 			}
@@ -266,7 +275,7 @@ func DecodeBinaryType(r Reader) (Any, error) {
 		}
 		return nil, errors.New("not implemented")
 	case BinaryTypeNullable: // <nested_type_encoding>
-		nested, err := DecodeBinaryType(r)
+		nested, err := decodeBinaryType(r, level+1, maxLevel)
 		if err != nil {
 			return nil, err
 		}
@@ -276,17 +285,17 @@ func DecodeBinaryType(r Reader) (Any, error) {
 	case BinaryTypeAggregateFunction:
 		return nil, errors.New("not implemented")
 	case BinaryTypeLowCardinality: // <nested_type_encoding>
-		nested, err := DecodeBinaryType(r)
+		nested, err := decodeBinaryType(r, level+1, maxLevel)
 		if err != nil {
 			return nil, err
 		}
 		return LowCardinalityAny(nested), nil
 	case BinaryTypeMap: // <key_type_encoding><value_type_encoding>
-		keyType, err := DecodeBinaryType(r)
+		keyType, err := decodeBinaryType(r, level+1, maxLevel)
 		if err != nil {
 			return nil, err
 		}
-		valueType, err := DecodeBinaryType(r)
+		valueType, err := decodeBinaryType(r, level+1, maxLevel)
 		if err != nil {
 			return nil, err
 		}
@@ -296,13 +305,13 @@ func DecodeBinaryType(r Reader) (Any, error) {
 	case BinaryTypeIPv6:
 		return IPv6, nil
 	case BinaryTypeVariant: // <var_uint_number_of_variants><variant_type_encoding_1>...<variant_type_encoding_N>
-		n, err := binary.ReadUvarint(r)
-		types := make([]Any, 0, n)
+		n, err := readCollectionLen(r)
+		types := make([]Any, 0, preallocLen(n))
 		if err != nil {
 			return nil, err
 		}
 		for range n {
-			tp, err := DecodeBinaryType(r)
+			tp, err := decodeBinaryType(r, level+1, maxLevel)
 			if err != nil {
 				return nil, err
 			}
@@ -328,18 +337,18 @@ func DecodeBinaryType(r Reader) (Any, error) {
 	case BinaryTypeSimpleAggregateFunction:
 		return nil, errors.New("not implemented")
 	case BinaryTypeNested: // <var_uint_number_of_elements><var_uint_name_size_1><name_data_1><nested_type_encoding_1>...<var_uint_name_size_N><name_data_N><nested_type_encoding_N>
-		n, err := binary.ReadUvarint(r)
+		n, err := readCollectionLen(r)
 		if err != nil {
 			return nil, err
 		}
-		columns := make([]Column, 0, n)
-		for i := 0; i < int(n); i++ {
+		columns := make([]Column, 0, preallocLen(n))
+		for i := 0; i < n; i++ {
 			var name string
 			err := String.Scan(r, &name)
 			if err != nil {
 				return nil, err
 			}
-			tp, err := DecodeBinaryType(r)
+			tp, err := decodeBinaryType(r, level+1, maxLevel)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,388 @@
+package rowbinary
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TypeFor returns natural ClickHouse type for Go type T:
+//   - bool, string, []byte, integers and floats map to the types of the same size (int and uint are 64 bit)
+//   - time.Time maps to DateTime64(9), uuid.UUID to UUID, ValueDate to Date and Value to Dynamic
+//   - slices map to Array, maps to Map and pointers to Nullable of the element types
+//
+// Unsupported types produce Invalid type which returns error describing the Go type on use.
+//
+// Slices, maps and pointers of built-in element types (e.g. []string, map[string]uint64, *float64) return
+// the same types as Array, Map and Nullable constructors. Other types (e.g. nested containers or named types)
+// convert values with reflection, use explicit constructors like Array(Array(String)) in performance sensitive code.
+func TypeFor[T any]() Type[T] {
+	rt := reflect.TypeFor[T]()
+	c, err := typeForReflect(rt)
+	if err != nil {
+		return Invalid[T](err.Error())
+	}
+	if tp, ok := c.tp.(Type[T]); ok {
+		return tp
+	}
+	return MakeTypeWrapAny[T](typeReflect[T]{conv: c})
+}
+
+// typeConv converts Go values of some type to values accepted by tp and back
+type typeConv struct {
+	tp      Any
+	toAny   func(v reflect.Value) any
+	fromAny func(a any, v reflect.Value) error
+}
+
+var (
+	typeForTime      = reflect.TypeFor[time.Time]()
+	typeForUUID      = reflect.TypeFor[uuid.UUID]()
+	typeForValueDate = reflect.TypeFor[ValueDate]()
+	typeForValue     = reflect.TypeFor[Value]()
+	typeForDecimal   = reflect.TypeFor[decimal.Decimal]()
+	typeForBytes     = reflect.TypeFor[[]byte]()
+)
+
+// typeForKit builds generic container types of element type, so TypeFor avoids reflection for them
+type typeForKit interface {
+	array() Any
+	nullable() Any
+	mapOf(key typeForKit) Any
+}
+
+type typeForKitOf[V any] struct {
+	tp Type[V]
+}
+
+func (k typeForKitOf[V]) array() Any {
+	return Array(k.tp)
+}
+
+func (k typeForKitOf[V]) nullable() Any {
+	return Nullable(k.tp)
+}
+
+// mapOf returns Map(key, V) or nil if there is no kit of key type or it is not comparable
+func (k typeForKitOf[V]) mapOf(key typeForKit) Any {
+	switch kt := key.(type) {
+	case typeForKitOf[bool]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[string]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[int8]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[int16]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[int32]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[int64]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[uint8]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[uint16]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[uint32]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[uint64]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[float32]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[float64]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[uuid.UUID]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[ValueDate]:
+		return Map(kt.tp, k.tp)
+	case typeForKitOf[time.Time]:
+		return Map(kt.tp, k.tp)
+	}
+	return nil
+}
+
+// typeForKits contains kits of Go types which TypeFor maps to built-in types without conversion
+var typeForKits = map[reflect.Type]typeForKit{
+	reflect.TypeFor[bool]():      typeForKitOf[bool]{Bool},
+	reflect.TypeFor[string]():    typeForKitOf[string]{String},
+	reflect.TypeFor[int8]():      typeForKitOf[int8]{Int8},
+	reflect.TypeFor[int16]():     typeForKitOf[int16]{Int16},
+	reflect.TypeFor[int32]():     typeForKitOf[int32]{Int32},
+	reflect.TypeFor[int64]():     typeForKitOf[int64]{Int64},
+	reflect.TypeFor[uint8]():     typeForKitOf[uint8]{UInt8},
+	reflect.TypeFor[uint16]():    typeForKitOf[uint16]{UInt16},
+	reflect.TypeFor[uint32]():    typeForKitOf[uint32]{UInt32},
+	reflect.TypeFor[uint64]():    typeForKitOf[uint64]{UInt64},
+	reflect.TypeFor[float32]():   typeForKitOf[float32]{Float32},
+	reflect.TypeFor[float64]():   typeForKitOf[float64]{Float64},
+	reflect.TypeFor[[]byte]():    typeForKitOf[[]byte]{StringBytes},
+	reflect.TypeFor[uuid.UUID](): typeForKitOf[uuid.UUID]{UUID},
+	reflect.TypeFor[ValueDate](): typeForKitOf[ValueDate]{Date},
+	reflect.TypeFor[time.Time](): typeForKitOf[time.Time]{DateTime64(9)},
+	reflect.TypeFor[Value]():     typeForKitOf[Value]{Dynamic(0)},
+}
+
+func typeForReflect(rt reflect.Type) (*typeConv, error) {
+	switch rt {
+	case typeForTime:
+		return typeForScalar(DateTime64(9), rt), nil
+	case typeForUUID:
+		return typeForScalar(UUID, rt), nil
+	case typeForValueDate:
+		return typeForScalar(Date, rt), nil
+	case typeForValue:
+		return typeForScalar(Dynamic(0), rt), nil
+	case typeForDecimal:
+		return nil, fmt.Errorf("type %s requires precision and scale, use Decimal(P, S)", rt)
+	}
+
+	switch rt.Kind() {
+	case reflect.Bool:
+		return typeForScalar(Bool, reflect.TypeFor[bool]()), nil
+	case reflect.String:
+		return typeForScalar(String, reflect.TypeFor[string]()), nil
+	case reflect.Int8:
+		return typeForScalar(Int8, reflect.TypeFor[int8]()), nil
+	case reflect.Int16:
+		return typeForScalar(Int16, reflect.TypeFor[int16]()), nil
+	case reflect.Int32:
+		return typeForScalar(Int32, reflect.TypeFor[int32]()), nil
+	case reflect.Int64, reflect.Int:
+		return typeForScalar(Int64, reflect.TypeFor[int64]()), nil
+	case reflect.Uint8:
+		return typeForScalar(UInt8, reflect.TypeFor[uint8]()), nil
+	case reflect.Uint16:
+		return typeForScalar(UInt16, reflect.TypeFor[uint16]()), nil
+	case reflect.Uint32:
+		return typeForScalar(UInt32, reflect.TypeFor[uint32]()), nil
+	case reflect.Uint64, reflect.Uint:
+		return typeForScalar(UInt64, reflect.TypeFor[uint64]()), nil
+	case reflect.Float32:
+		return typeForScalar(Float32, reflect.TypeFor[float32]()), nil
+	case reflect.Float64:
+		return typeForScalar(Float64, reflect.TypeFor[float64]()), nil
+	case reflect.Slice:
+		if rt.Elem() == typeForBytes.Elem() {
+			return typeForScalar(StringBytes, typeForBytes), nil
+		}
+		return typeForSlice(rt)
+	case reflect.Map:
+		return typeForMap(rt)
+	case reflect.Pointer:
+		return typeForPointer(rt)
+	}
+	return nil, fmt.Errorf("no ClickHouse type for Go type %s", rt)
+}
+
+// typeForScalar converts values with reflect.Value.Convert, so named types like "type ID uint64" are supported
+func typeForScalar(tp Any, canonical reflect.Type) *typeConv {
+	return &typeConv{
+		tp: tp,
+		toAny: func(v reflect.Value) any {
+			if v.Type() == canonical {
+				return v.Interface()
+			}
+			return v.Convert(canonical).Interface()
+		},
+		fromAny: func(a any, v reflect.Value) error {
+			av := reflect.ValueOf(a)
+			if !av.IsValid() || !av.Type().ConvertibleTo(v.Type()) {
+				return fmt.Errorf("unexpected type %T", a)
+			}
+			v.Set(av.Convert(v.Type()))
+			return nil
+		},
+	}
+}
+
+func typeForSlice(rt reflect.Type) (*typeConv, error) {
+	if kit, ok := typeForKits[rt.Elem()]; ok {
+		return typeForScalar(kit.array(), reflect.SliceOf(rt.Elem())), nil
+	}
+	elem, err := typeForReflect(rt.Elem())
+	if err != nil {
+		return nil, err
+	}
+	return &typeConv{
+		tp: ArrayAny(elem.tp),
+		toAny: func(v reflect.Value) any {
+			ret := make([]any, v.Len())
+			for i := range ret {
+				ret[i] = elem.toAny(v.Index(i))
+			}
+			return ret
+		},
+		fromAny: func(a any, v reflect.Value) error {
+			src, ok := a.([]any)
+			if !ok {
+				return fmt.Errorf("unexpected type %T", a)
+			}
+			ret := reflect.MakeSlice(v.Type(), len(src), len(src))
+			for i := range src {
+				if err := elem.fromAny(src[i], ret.Index(i)); err != nil {
+					return err
+				}
+			}
+			v.Set(ret)
+			return nil
+		},
+	}, nil
+}
+
+func typeForMap(rt reflect.Type) (*typeConv, error) {
+	key, err := typeForReflect(rt.Key())
+	if err != nil {
+		return nil, err
+	}
+	value, err := typeForReflect(rt.Elem())
+	if err != nil {
+		return nil, err
+	}
+	if valueKit, ok := typeForKits[rt.Elem()]; ok {
+		if tp := valueKit.mapOf(typeForKits[rt.Key()]); tp != nil {
+			return typeForScalar(tp, reflect.MapOf(rt.Key(), rt.Elem())), nil
+		}
+	}
+	return &typeConv{
+		tp: MapAny(key.tp, value.tp),
+		toAny: func(v reflect.Value) any {
+			ret := make(map[any]any, v.Len())
+			iter := v.MapRange()
+			for iter.Next() {
+				ret[key.toAny(iter.Key())] = value.toAny(iter.Value())
+			}
+			return ret
+		},
+		fromAny: func(a any, v reflect.Value) error {
+			src, ok := a.(map[any]any)
+			if !ok {
+				return fmt.Errorf("unexpected type %T", a)
+			}
+			ret := reflect.MakeMapWithSize(v.Type(), len(src))
+			k := reflect.New(rt.Key()).Elem()
+			e := reflect.New(rt.Elem()).Elem()
+			for sk, se := range src {
+				if err := key.fromAny(sk, k); err != nil {
+					return err
+				}
+				if err := value.fromAny(se, e); err != nil {
+					return err
+				}
+				ret.SetMapIndex(k, e)
+			}
+			v.Set(ret)
+			return nil
+		},
+	}, nil
+}
+
+func typeForPointer(rt reflect.Type) (*typeConv, error) {
+	elem, err := typeForReflect(rt.Elem())
+	if err != nil {
+		return nil, err
+	}
+	switch rt.Elem().Kind() {
+	case reflect.Slice, reflect.Map, reflect.Pointer:
+		if rt.Elem() != typeForBytes {
+			return nil, fmt.Errorf("type Nullable(%s) for Go type %s is not supported by ClickHouse", elem.tp.String(), rt)
+		}
+	}
+	if rt.Elem() == typeForValue {
+		return nil, fmt.Errorf("type Nullable(%s) for Go type %s is not supported by ClickHouse", elem.tp.String(), rt)
+	}
+	if kit, ok := typeForKits[rt.Elem()]; ok {
+		return typeForScalar(kit.nullable(), reflect.PointerTo(rt.Elem())), nil
+	}
+	return &typeConv{
+		tp: NullableAny(elem.tp),
+		toAny: func(v reflect.Value) any {
+			if v.IsNil() {
+				return (*any)(nil)
+			}
+			x := elem.toAny(v.Elem())
+			return &x
+		},
+		fromAny: func(a any, v reflect.Value) error {
+			src, ok := a.(*any)
+			if !ok {
+				return fmt.Errorf("unexpected type %T", a)
+			}
+			if src == nil {
+				v.SetZero()
+				return nil
+			}
+			ret := reflect.New(rt.Elem())
+			if err := elem.fromAny(*src, ret.Elem()); err != nil {
+				return err
+			}
+			v.Set(ret)
+			return nil
+		},
+	}, nil
+}
+
+// typeReflect is returned by TypeFor for Go types without built-in generic type
+type typeReflect[T any] struct {
+	conv *typeConv
+}
+
+func (t typeReflect[T]) String() string {
+	return t.conv.tp.String()
+}
+
+func (t typeReflect[T]) Binary() []byte {
+	return t.conv.tp.Binary()
+}
+
+func (t typeReflect[T]) Write(w Writer, v T) error {
+	return t.conv.tp.WriteAny(w, t.conv.toAny(reflect.ValueOf(&v).Elem()))
+}
+
+func (t typeReflect[T]) Append(dst []byte, v T) ([]byte, error) {
+	return appendAny(dst, t.conv.tp, t.conv.toAny(reflect.ValueOf(&v).Elem()))
+}
+
+func (t typeReflect[T]) Scan(r Reader, v *T) error {
+	var a any
+	if err := t.conv.tp.ScanAny(r, &a); err != nil {
+		return err
+	}
+	return t.conv.fromAny(a, reflect.ValueOf(v).Elem())
+}
+
+func (t typeReflect[T]) Skip(r Reader) error {
+	return Skip(r, t.conv.tp)
+}
+
+func (t typeReflect[T]) coerce(v any) (T, error) {
+	var ret T
+	if value, ok := v.(T); ok {
+		return value, nil
+	}
+	a, err := coerceAny(t.conv.tp, v)
+	if err != nil {
+		return ret, err
+	}
+	if err := t.conv.fromAny(a, reflect.ValueOf(&ret).Elem()); err != nil {
+		return ret, newTypeMismatchError[T](v)
+	}
+	return ret, nil
+}
+
+func (t typeReflect[T]) AppendLiteral(dst []byte, v T) ([]byte, error) {
+	return appendLiteralAny(dst, t.conv.tp, t.conv.toAny(reflect.ValueOf(&v).Elem()))
+}
+
+func (t typeReflect[T]) ScanLiteral(s *LiteralScanner, v *T) error {
+	a, err := scanLiteralAny(s, t.conv.tp)
+	if err != nil {
+		return err
+	}
+	return t.conv.fromAny(a, reflect.ValueOf(v).Elem())
+}
+
+func (t typeReflect[T]) AppendJSON(dst []byte, v T, opts *JSONOptions) ([]byte, error) {
+	return appendJSONAny(dst, t.conv.tp, t.conv.toAny(reflect.ValueOf(&v).Elem()), opts)
+}
@@ -0,0 +1,84 @@
+package rowbinary
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTypeForRoundTrip[T any](t *testing.T, expected string, value T) {
+	t.Helper()
+	tp := TypeFor[T]()
+	assert.Equal(t, expected, tp.String())
+
+	var buf bytes.Buffer
+	assert.NoError(t, tp.Write(NewWriter(&buf), value))
+
+	var ret T
+	assert.NoError(t, tp.Scan(NewReader(bytes.NewReader(buf.Bytes())), &ret))
+	assert.Equal(t, value, ret)
+}
+
+func TestTypeFor(t *testing.T) {
+	type ID uint32
+
+	assert.Same(t, Int32, TypeFor[int32]())
+	assert.Same(t, StringBytes, TypeFor[[]byte]())
+
+	// containers of built-in types are the same as built by constructors
+	assert.Equal(t, Array(String), TypeFor[[]string]())
+	assert.Equal(t, Map(String, UInt64), TypeFor[map[string]uint64]())
+	assert.Equal(t, Nullable(Float64), TypeFor[*float64]())
+	assert.Equal(t, Nullable(StringBytes), TypeFor[*[]byte]())
+	assert.Equal(t, Array(UUID), TypeFor[[]uuid.UUID]())
+	// nested containers use reflection
+	assert.NotEqual(t, Array(Array(String)), TypeFor[[][]string]())
+	assert.Equal(t, "Array(Array(String))", TypeFor[[][]string]().String())
+
+	testTypeForRoundTrip(t, "Int64", 42)
+	testTypeForRoundTrip(t, "UInt32", ID(42))
+	testTypeForRoundTrip(t, "Array(String)", []string{"a", "b"})
+	testTypeForRoundTrip(t, "Map(String, UInt64)", map[string]uint64{"a": 1})
+	testTypeForRoundTrip(t, "Nullable(Float64)", pointer(1.5))
+	testTypeForRoundTrip(t, "Nullable(Float64)", (*float64)(nil))
+	testTypeForRoundTrip(t, "UUID", uuid.MustParse("61f0c404-5cb3-11e7-907b-a6006ad3dba0"))
+	testTypeForRoundTrip(t, "DateTime64(9)", time.Date(2024, 2, 29, 10, 11, 12, 13, time.UTC))
+	testTypeForRoundTrip(t, "Array(Map(UInt32, Array(Nullable(String))))", []map[ID][]*string{{1: {nil, pointer("x")}}})
+}
+
+func TestTypeFor_Invalid(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tp := range []Any{
+		TypeFor[struct{}](),
+		TypeFor[[]chan int](),
+		TypeFor[decimal.Decimal](),
+		TypeFor[*[]int](),
+	} {
+		assert.Equal("Invalid", tp.String())
+		var v any
+		err := tp.ScanAny(NewReader(bytes.NewReader(nil)), &v)
+		var invalid InvalidTypeError
+		assert.ErrorAs(err, &invalid)
+	}
+}
+
+func TestTypeFor_Literal(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := FormatLiteral(TypeFor[[]int](), []int{1, 2})
+	assert.NoError(err)
+	assert.Equal("[1, 2]", s)
+
+	v, err := ParseLiteral(TypeFor[map[string]*int](), "{'a': 1, 'b': NULL}")
+	assert.NoError(err)
+	assert.Equal(map[string]*int{"a": pointer(1), "b": nil}, v)
+
+	c, err := Coerce(TypeFor[[]int16](), []uint8{1, 2})
+	assert.NoError(err)
+	assert.Equal([]int16{1, 2}, c)
+}
@@ -2,6 +2,7 @@ package rowbinary
 
 import (
 	"encoding/binary"
+	"strconv"
 )
 
 var UInt16 Type[uint16] = MakeTypeWrapAny[uint16](typeUInt16{})
@@ -22,6 +23,10 @@ func (t typeUInt16) Write(w Writer, v uint16) error {
 	return err
 }
 
+func (t typeUInt16) Append(dst []byte, v uint16) ([]byte, error) {
+	return binary.LittleEndian.AppendUint16(dst, v), nil
+}
+
 func (t typeUInt16) Scan(r Reader, v *uint16) error {
 	b, err := r.Peek(2)
 	if err != nil {
@@ -33,3 +38,21 @@ func (t typeUInt16) Scan(r Reader, v *uint16) error {
 	}
 	return nil
 }
+
+func (t typeUInt16) Skip(r Reader) error {
+	return skipBytes(r, 2)
+}
+
+func (t typeUInt16) AppendLiteral(dst []byte, v uint16) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
+
+func (t typeUInt16) ScanLiteral(s *LiteralScanner, v *uint16) error {
+	n, err := s.Uint(16)
+	*v = uint16(n)
+	return err
+}
+
+func (t typeUInt16) AppendJSON(dst []byte, v uint16, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
@@ -2,6 +2,7 @@ package rowbinary
 
 import (
 	"encoding/binary"
+	"strconv"
 )
 
 var UInt32 Type[uint32] = MakeTypeWrapAny[uint32](typeUInt32{})
@@ -22,6 +23,10 @@ func (t typeUInt32) Write(w Writer, v uint32) error {
 	return err
 }
 
+func (t typeUInt32) Append(dst []byte, v uint32) ([]byte, error) {
+	return binary.LittleEndian.AppendUint32(dst, v), nil
+}
+
 func (t typeUInt32) Scan(r Reader, v *uint32) error {
 	b, err := r.Peek(4)
 	if err != nil {
@@ -33,3 +38,21 @@ func (t typeUInt32) Scan(r Reader, v *uint32) error {
 	}
 	return nil
 }
+
+func (t typeUInt32) Skip(r Reader) error {
+	return skipBytes(r, 4)
+}
+
+func (t typeUInt32) AppendLiteral(dst []byte, v uint32) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
+
+func (t typeUInt32) ScanLiteral(s *LiteralScanner, v *uint32) error {
+	n, err := s.Uint(32)
+	*v = uint32(n)
+	return err
+}
+
+func (t typeUInt32) AppendJSON(dst []byte, v uint32, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
@@ -2,6 +2,7 @@ package rowbinary
 
 import (
 	"encoding/binary"
+	"strconv"
 )
 
 var UInt64 Type[uint64] = MakeTypeWrapAny[uint64](typeUInt64{})
@@ -22,6 +23,10 @@ func (t typeUInt64) Write(w Writer, value uint64) error {
 	return err
 }
 
+func (t typeUInt64) Append(dst []byte, v uint64) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, v), nil
+}
+
 func (t typeUInt64) Scan(r Reader, v *uint64) error {
 	b, err := r.Peek(8)
 	if err != nil {
@@ -33,3 +38,21 @@ func (t typeUInt64) Scan(r Reader, v *uint64) error {
 	}
 	return nil
 }
+
+func (t typeUInt64) Skip(r Reader) error {
+	return skipBytes(r, 8)
+}
+
+func (t typeUInt64) AppendLiteral(dst []byte, v uint64) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
+
+func (t typeUInt64) ScanLiteral(s *LiteralScanner, v *uint64) error {
+	n, err := s.Uint(64)
+	*v = uint64(n)
+	return err
+}
+
+func (t typeUInt64) AppendJSON(dst []byte, v uint64, opts *JSONOptions) ([]byte, error) {
+	return appendJSONUint64(dst, v, opts), nil
+}
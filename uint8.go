@@ -1,5 +1,7 @@
 package rowbinary
 
+import "strconv"
+
 var UInt8 Type[uint8] = MakeTypeWrapAny[uint8](typeUInt8{})
 
 type typeUInt8 struct{}
@@ -16,7 +18,29 @@ func (t typeUInt8) Write(w Writer, value uint8) error {
 	return w.WriteByte(value)
 }
 
+func (t typeUInt8) Append(dst []byte, v uint8) ([]byte, error) {
+	return append(dst, v), nil
+}
+
 func (t typeUInt8) Scan(r Reader, v *uint8) (err error) {
 	*v, err = r.ReadByte()
 	return
 }
+
+func (t typeUInt8) Skip(r Reader) error {
+	return skipBytes(r, 1)
+}
+
+func (t typeUInt8) AppendLiteral(dst []byte, v uint8) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
+
+func (t typeUInt8) ScanLiteral(s *LiteralScanner, v *uint8) error {
+	n, err := s.Uint(8)
+	*v = uint8(n)
+	return err
+}
+
+func (t typeUInt8) AppendJSON(dst []byte, v uint8, opts *JSONOptions) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(v), 10), nil
+}
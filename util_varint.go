@@ -3,8 +3,11 @@ package rowbinary
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 )
 
+var errVarintOverflow = errors.New("varint overflows a 64-bit integer")
+
 func VarintWrite(w Writer, x uint64) error {
 	var err error
 	i := 0
@@ -29,6 +29,13 @@ func (t typeUUID) Write(w Writer, value uuid.UUID) error {
 	return err
 }
 
+func (t typeUUID) Append(dst []byte, v uuid.UUID) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, v[:]...)
+	swap64(dst[start:])
+	return dst, nil
+}
+
 func (t typeUUID) Scan(r Reader, v *uuid.UUID) error {
 	b, err := r.Peek(16)
 	if err != nil {
@@ -44,3 +51,27 @@ func (t typeUUID) Scan(r Reader, v *uuid.UUID) error {
 	_, err = r.Discard(16)
 	return err
 }
+
+func (t typeUUID) Skip(r Reader) error {
+	return skipBytes(r, 16)
+}
+
+func (t typeUUID) AppendLiteral(dst []byte, v uuid.UUID) ([]byte, error) {
+	return AppendQuotedLiteral(dst, v.String()), nil
+}
+
+func (t typeUUID) ScanLiteral(s *LiteralScanner, v *uuid.UUID) error {
+	q, err := s.Quoted()
+	if err != nil {
+		return err
+	}
+	*v, err = uuid.Parse(q)
+	if err != nil {
+		return s.Errorf("%s", err)
+	}
+	return nil
+}
+
+func (t typeUUID) AppendJSON(dst []byte, v uuid.UUID, opts *JSONOptions) ([]byte, error) {
+	return appendJSONString(dst, v.String()), nil
+}
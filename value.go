@@ -7,6 +7,22 @@ type Value struct {
 	Value any
 }
 
+// String returns value as Type(literal), e.g. Array(UInt8)([1, 2]).
+// Values which can't be rendered as literal fall back to %v.
 func (v Value) String() string {
+	if v.Type == nil {
+		return fmt.Sprintf("<nil>(%v)", v.Value)
+	}
+	if lit, err := FormatLiteralAny(v.Type, v.Value); err == nil {
+		return fmt.Sprintf("%s(%s)", v.Type.String(), lit)
+	}
 	return fmt.Sprintf("%s(%v)", v.Type.String(), v.Value)
 }
+
+// MarshalJSON renders value as ClickHouse JSON with DefaultJSONOptions. Value without type is null.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if v.Type == nil {
+		return []byte("null"), nil
+	}
+	return AppendJSONAny(nil, v.Type, v.Value, nil)
+}
@@ -42,7 +42,19 @@ func (t typeVariant) Write(w Writer, value Value) error {
 			return value.Type.WriteAny(w, value.Value)
 		}
 	}
-	return TypeMismatchError{}
+	return TypeMismatchError{ExpectedType: t.String(), ActualType: value.Type.String()}
+}
+
+func (t typeVariant) Append(dst []byte, v Value) ([]byte, error) {
+	if v.Type == nil {
+		return dst, TypeMismatchError{ExpectedType: t.String(), ActualType: "<nil>"}
+	}
+	for i, tp := range t.valueTypes {
+		if tp.ID() == v.Type.ID() {
+			return appendAny(binary.AppendUvarint(dst, uint64(i)), v.Type, v.Value)
+		}
+	}
+	return dst, TypeMismatchError{ExpectedType: t.String(), ActualType: v.Type.String()}
 }
 
 func (t typeVariant) Scan(r Reader, v *Value) error {
@@ -56,3 +68,56 @@ func (t typeVariant) Scan(r Reader, v *Value) error {
 	v.Type = t.valueTypes[n]
 	return v.Type.ScanAny(r, &v.Value)
 }
+
+func (t typeVariant) Skip(r Reader) error {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if n >= uint64(len(t.valueTypes)) {
+		return fmt.Errorf("invalid variant index: %d", n)
+	}
+	return Skip(r, t.valueTypes[n])
+}
+
+func (t typeVariant) AppendLiteral(dst []byte, value Value) ([]byte, error) {
+	if value.Type == nil {
+		return append(dst, "NULL"...), nil
+	}
+	for _, tp := range t.valueTypes {
+		if tp.ID() == value.Type.ID() {
+			return appendLiteralAny(dst, value.Type, value.Value)
+		}
+	}
+	return dst, TypeMismatchError{ExpectedType: t.String(), ActualType: value.Type.String()}
+}
+
+// ScanLiteral parses NULL or the literal of the first variant type which accepts it
+func (t typeVariant) ScanLiteral(s *LiteralScanner, v *Value) error {
+	if s.ConsumeKeyword("NULL") {
+		*v = Value{}
+		return nil
+	}
+	pos := s.pos
+	for _, tp := range t.valueTypes {
+		x, err := scanLiteralAny(s, tp)
+		if err == nil {
+			*v = Value{Type: tp, Value: x}
+			return nil
+		}
+		s.pos = pos
+	}
+	return s.Errorf("value doesn't match any of %s", t.String())
+}
+
+func (t typeVariant) AppendJSON(dst []byte, value Value, opts *JSONOptions) ([]byte, error) {
+	if value.Type == nil {
+		return append(dst, "null"...), nil
+	}
+	for _, tp := range t.valueTypes {
+		if tp.ID() == value.Type.ID() {
+			return appendJSONAny(dst, value.Type, value.Value, opts)
+		}
+	}
+	return dst, TypeMismatchError{ExpectedType: t.String(), ActualType: value.Type.String()}
+}
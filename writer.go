@@ -52,3 +52,23 @@ func (bw *implByteWriter) WriteByte(b byte) error {
 	_, err := bw.Write(bw.b)
 	return err
 }
+
+// countingWriter counts bytes written to the wrapped Writer
+type countingWriter struct {
+	Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+func (w *countingWriter) WriteByte(b byte) error {
+	err := w.Writer.WriteByte(b)
+	if err == nil {
+		w.n++
+	}
+	return err
+}